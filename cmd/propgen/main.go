@@ -0,0 +1,256 @@
+// Command propgen generates the plain helpers of the prop package and their constants from the dataset.
+// The helpers are listed in spec/helpers.json, the elements of their doc comments come from attributes.json.
+// With -check it only reports whether the generated file is up to date.
+//
+//	go generate ./prop
+//	go run ./cmd/propgen -check
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/Hand-of-Doom/Vecty-Props/spec"
+)
+
+func main() {
+	_, file, _, _ := runtime.Caller(0)
+	out := flag.String("out", filepath.Join(filepath.Dir(file), "..", "..", "prop", "prop_gen.go"), "file to generate")
+	check := flag.Bool("check", false, "report whether the file is up to date instead of writing it")
+	flag.Parse()
+
+	src, err := generate(spec.Load(), spec.LoadGenerated())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	if *check {
+		current, err := os.ReadFile(*out)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+		if !bytes.Equal(current, src) {
+			fmt.Printf("FAIL %s is out of date, run go generate ./prop\n", *out)
+			os.Exit(1)
+		}
+
+		fmt.Printf("ok %s\n", *out)
+		return
+	}
+
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+}
+
+// checkedTypes are the hand-written types of the prop package that have a check method like the enums
+var checkedTypes = map[string]bool{
+	"MIME": true,
+	"URL":  true,
+}
+
+func generate(ds *spec.Dataset, gen *spec.Generated) ([]byte, error) {
+	enums := map[string]spec.Enum{}
+	for _, enum := range gen.Enums {
+		if _, ok := enums[enum.Type]; ok {
+			return nil, fmt.Errorf("enum %s is listed twice", enum.Type)
+		}
+		if _, ok := ds.Attribute(enum.Attribute); !ok {
+			return nil, fmt.Errorf("enum %s is checked for %s, which is not in the dataset", enum.Type, enum.Attribute)
+		}
+		enums[enum.Type] = enum
+	}
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by propgen from spec; DO NOT EDIT.\n\n")
+	b.WriteString("package prop\n\n")
+	b.WriteString("import (\n\t\"strings\"\n\n\t\"github.com/hexops/vecty\"\n)\n")
+
+	written := map[string]bool{}
+	for _, fn := range gen.Funcs {
+		attr, ok := ds.Attribute(fn.Attribute)
+		if !ok {
+			return nil, fmt.Errorf("%s sets %s, which is not in the dataset", fn.Name, fn.Attribute)
+		}
+		if owner, ok := ds.Helper(fn.Name); !ok || owner.Name != attr.Name {
+			return nil, fmt.Errorf("%s is not a helper of %s in the dataset", fn.Name, attr.Name)
+		}
+		for _, e := range fn.Elements {
+			if !attr.AppliesTo(e) {
+				return nil, fmt.Errorf("%s is listed for <%s>, which %s doesn't apply to", fn.Name, e, attr.Name)
+			}
+		}
+
+		_, checked := enums[fn.Type]
+		if checked && !written[fn.Type] {
+			writeEnum(&b, enums[fn.Type])
+			written[fn.Type] = true
+		}
+
+		writeFunc(&b, fn, attr, checked || checkedTypes[fn.Type])
+	}
+
+	// the enums of the hand-written helpers and builders
+	for _, enum := range gen.Enums {
+		if !written[enum.Type] {
+			writeEnum(&b, enum)
+		}
+	}
+
+	return format.Source(b.Bytes())
+}
+
+func writeEnum(b *bytes.Buffer, enum spec.Enum) {
+	b.WriteString("\n")
+	if enum.Doc != "" {
+		fmt.Fprintf(b, "// %s %s\n", enum.Type, enum.Doc)
+	}
+	if enum.Deprecated != "" {
+		fmt.Fprintf(b, "//\n// Deprecated: %s\n", enum.Deprecated)
+	}
+	fmt.Fprintf(b, "type %s string\n\nconst (\n", enum.Type)
+
+	var values, deprecated []string
+	for _, c := range enum.Constants {
+		if c.Deprecated != "" {
+			deprecated = append(deprecated, fmt.Sprintf("\t// Deprecated: %s\n%s", c.Deprecated, constSpec(enum, c)))
+			continue
+		}
+
+		b.WriteString(constSpec(enum, c))
+		if c.Alias == "" {
+			values = append(values, c.Name)
+		}
+	}
+	if len(deprecated) != 0 {
+		b.WriteString("\n" + strings.Join(deprecated, ""))
+	}
+	b.WriteString(")\n")
+
+	t, list := enum.Type, strings.Join(values, ", ")
+
+	fmt.Fprintf(b, "\n// %sValues returns the constants of %s, the deprecated ones are left out\n", t, t)
+	fmt.Fprintf(b, "func %sValues() []%s {\n\treturn []%s{%s}\n}\n", t, t, t, list)
+
+	fmt.Fprintf(b, "\n// Parse%s returns the constant that matches the value ignoring case", t)
+	if enum.Grammar != "" {
+		b.WriteString(", or the value when it is valid")
+	}
+	fmt.Fprintf(b, "\nfunc Parse%s(value string) (%s, error) {\n", t, t)
+	fmt.Fprintf(b, "\tfor _, c := range %sValues() {\n\t\tif strings.EqualFold(string(c), value) {\n\t\t\treturn c, nil\n\t\t}\n\t}\n\n", t)
+	fmt.Fprintf(b, "\tc := %s(value)\n\tif err := c.check(); err != nil {\n\t\treturn \"\", err\n\t}\n\n\treturn c, nil\n}\n", t)
+
+	switch enum.Grammar {
+	case "attribute":
+		fmt.Fprintf(b, "\n// Valid reports whether the %s attribute allows the value\n", enum.Attribute)
+		fmt.Fprintf(b, "func (c %s) Valid() bool {\n\treturn checkValue(%s, string(c)) == nil\n}\n", t, strconv.Quote(enum.Attribute))
+	case "mime":
+		fmt.Fprintf(b, "\n// Valid reports whether the value is one of the constants or a MIME type\n")
+		fmt.Fprintf(b, "func (c %s) Valid() bool {\n\tswitch c {\n\tcase %s:\n\t\treturn true\n\t}\n\n", t, list)
+		b.WriteString("\treturn validMIME(string(c))\n}\n")
+	default:
+		fmt.Fprintf(b, "\n// Valid reports whether the value is one of the constants\n")
+		fmt.Fprintf(b, "func (c %s) Valid() bool {\n\tswitch c {\n\tcase %s:\n\t\treturn true\n\t}\n\n\treturn false\n}\n", t, list)
+	}
+
+	fmt.Fprintf(b, "\nfunc (c %s) String() string {\n\treturn string(c)\n}\n", t)
+
+	fmt.Fprintf(b, "\nfunc (c %s) check() error {\n", t)
+	if kept := deprecatedValues(enum); len(kept) != 0 {
+		b.WriteString("\t// the deprecated constants stay valid, so the code that uses them doesn't start to panic\n")
+		fmt.Fprintf(b, "\tswitch c {\n\tcase %s:\n\t\treturn nil\n\t}\n\n", strings.Join(kept, ", "))
+	}
+	if enum.Grammar == "attribute" {
+		fmt.Fprintf(b, "\treturn checkValue(%s, string(c))\n}\n", strconv.Quote(enum.Attribute))
+		return
+	}
+
+	reason := make([]string, 0, len(values))
+	for _, c := range enum.Constants {
+		if c.Deprecated == "" && c.Alias == "" {
+			reason = append(reason, c.Value)
+		}
+	}
+	if enum.Grammar == "mime" {
+		reason = append(reason, "a MIME type")
+	}
+
+	fmt.Fprintf(b, "\tif c.Valid() {\n\t\treturn nil\n\t}\n\n")
+	fmt.Fprintf(b, "\treturn &ValueError{Attr: %s, Value: string(c), Reason: %s}\n}\n",
+		strconv.Quote(enum.Attribute), strconv.Quote("expected one of "+strings.Join(reason, ", ")))
+}
+
+// deprecatedValues returns the deprecated constants that are not aliases of another one
+func deprecatedValues(enum spec.Enum) []string {
+	var names []string
+	for _, c := range enum.Constants {
+		if c.Deprecated != "" && c.Alias == "" {
+			names = append(names, c.Name)
+		}
+	}
+
+	return names
+}
+
+func constSpec(enum spec.Enum, c spec.Const) string {
+	if c.Alias != "" {
+		return fmt.Sprintf("\t%s = %s\n", c.Name, c.Alias)
+	}
+
+	return fmt.Sprintf("\t%s %s = %s\n", c.Name, enum.Type, strconv.Quote(c.Value))
+}
+
+func writeFunc(b *bytes.Buffer, fn spec.Func, attr spec.Attribute, checked bool) {
+	elements := "Global Attributes"
+	if !attr.Global || len(fn.Elements) != 0 {
+		names := attr.Elements
+		if len(fn.Elements) != 0 {
+			names = fn.Elements
+		}
+
+		tags := make([]string, 0, len(names))
+		for _, e := range names {
+			tags = append(tags, "<"+e+">")
+		}
+		elements = strings.Join(tags, ", ")
+	}
+
+	fmt.Fprintf(b, "\n// %s %s", fn.Name, fn.Doc)
+	if checked {
+		fmt.Fprintf(b, ", it panics when the value is not valid, see %sE", fn.Name)
+	}
+	if fn.Type == "URL" {
+		b.WriteString(".\n// User input should go through ParseURL")
+	}
+	fmt.Fprintf(b, "\n//\n// %s\n", elements)
+	writeDeprecated(b, fn)
+
+	fmt.Fprintf(b, "func %s(%s %s) vecty.Applyer {\n", fn.Name, fn.Param, fn.Type)
+	if !checked {
+		fmt.Fprintf(b, "\treturn applyAttr(%s, %s)\n}\n", strconv.Quote(attr.Name), fn.Param)
+		return
+	}
+	fmt.Fprintf(b, "\tapplyer, err := %sE(%s)\n\tif err != nil {\n\t\tpanic(err)\n\t}\n\n\treturn applyer\n}\n", fn.Name, fn.Param)
+
+	fmt.Fprintf(b, "\n// %sE is %s that returns an error instead of panicking\n//\n// %s\n", fn.Name, fn.Name, elements)
+	writeDeprecated(b, fn)
+	fmt.Fprintf(b, "func %sE(%s %s) (vecty.Applyer, error) {\n", fn.Name, fn.Param, fn.Type)
+	fmt.Fprintf(b, "\tif err := %s.check(); err != nil {\n\t\treturn nil, err\n\t}\n\n", fn.Param)
+	fmt.Fprintf(b, "\treturn applyAttr(%s, %s.String()), nil\n}\n", strconv.Quote(attr.Name), fn.Param)
+}
+
+func writeDeprecated(b *bytes.Buffer, fn spec.Func) {
+	if fn.Deprecated != "" {
+		fmt.Fprintf(b, "//\n// Deprecated: %s\n", fn.Deprecated)
+	}
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Hand-of-Doom/Vecty-Props/spec"
+)
+
+// TestGenerated fails when prop/prop_gen.go is not what the dataset generates, run go generate ./prop then
+func TestGenerated(t *testing.T) {
+	src, err := generate(spec.Load(), spec.LoadGenerated())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	current, err := os.ReadFile(filepath.Join("..", "..", "prop", "prop_gen.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(current, src) {
+		t.Error("prop/prop_gen.go is out of date, run go generate ./prop")
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	ds := spec.Load()
+	enum := spec.LoadGenerated().Enums[0]
+
+	if _, err := generate(ds, &spec.Generated{Enums: []spec.Enum{enum, enum}}); err == nil {
+		t.Error("generate takes an enum listed twice")
+	}
+
+	enum.Attribute = "no-such-attribute"
+	if _, err := generate(ds, &spec.Generated{Enums: []spec.Enum{enum}}); err == nil {
+		t.Error("generate takes an enum of an attribute that is not in the dataset")
+	}
+}
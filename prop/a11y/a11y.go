@@ -0,0 +1,455 @@
+// Package a11y finds common accessibility mistakes in FakeDOM trees and rendered vecty trees
+package a11y
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Hand-of-Doom/Vecty-Props/prop"
+	"github.com/hexops/vecty"
+)
+
+// RuleCase names the check that produced a finding
+type RuleCase string
+
+const (
+	// RuleCaseImgAlt is an <img> without alt, alt="" marks a decorative image and is fine
+	RuleCaseImgAlt RuleCase = "img-alt"
+	// RuleCaseAreaAlt is an <area> with href but without alt
+	RuleCaseAreaAlt RuleCase = "area-alt"
+	// RuleCaseLabel is a form control without a <label for>, a wrapping <label>, aria-label or aria-labelledby
+	RuleCaseLabel RuleCase = "label"
+	// RuleCaseThScope is a <th> without scope in a table with more than one level of headers
+	RuleCaseThScope RuleCase = "th-scope"
+	// RuleCaseAccessKey is an access key that is used by more than one element
+	RuleCaseAccessKey RuleCase = "duplicate-accesskey"
+	// RuleCaseTabIndex is a positive tabindex, it breaks the tabbing order of the page
+	RuleCaseTabIndex RuleCase = "positive-tabindex"
+	// RuleCaseNoopener is target=_blank without rel=noopener or rel=noreferrer
+	RuleCaseNoopener RuleCase = "blank-noopener"
+	// RuleCaseTrackSrcLang is a subtitles <track> without srclang
+	RuleCaseTrackSrcLang RuleCase = "track-srclang"
+	// RuleCaseParse is RawNode content that can't be parsed, it is not checked
+	RuleCaseParse RuleCase = "parse"
+)
+
+// Finding is a single problem in the tree
+type Finding struct {
+	Rule RuleCase
+	// Path locates the element, the index counts the siblings with the same name
+	// ex: /html/body/form/input[2]
+	Path    string
+	Element string
+	Attr    string
+	Message string
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s: %s: %s", f.Path, f.Rule, f.Message)
+}
+
+// Findings fails a test suite, the error lists every finding
+type Findings []Finding
+
+func (e Findings) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, f := range e {
+		messages = append(messages, f.String())
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// Err returns nil when there are no findings
+func (e Findings) Err() error {
+	if len(e) == 0 {
+		return nil
+	}
+
+	return e
+}
+
+// element is a node with the path to it and its ancestors, the nearest is the last
+type element struct {
+	node      *prop.Node
+	path      string
+	ancestors []*prop.Node
+}
+
+func (e element) attr(key string) (string, bool) {
+	return e.node.Attr(key)
+}
+
+// collect flattens the tree into the elements in document order, RawNode content is parsed
+func collect(tree prop.FakeDOM, path string, ancestors []*prop.Node, elements *[]element, findings *Findings) {
+	var nodes []prop.FakeDOM
+	switch tree := tree.(type) {
+	case *prop.Document:
+		nodes = tree.Nodes()
+	case *prop.Node:
+		nodes = tree.Nodes()
+		ancestors = append(ancestors[:len(ancestors):len(ancestors)], tree)
+	case *prop.RawNode:
+		parsed, err := prop.ParseFakeDOM(strings.NewReader(tree.HTML()))
+		if err != nil {
+			*findings = append(*findings, Finding{Rule: RuleCaseParse, Path: path, Message: err.Error()})
+			return
+		}
+
+		nodes = parsed.(*prop.Document).Nodes()
+	default:
+		return
+	}
+
+	counts := map[string]int{}
+	for _, node := range flatten(nodes) {
+		counts[node.Name()]++
+	}
+
+	seen := map[string]int{}
+	for _, node := range nodes {
+		n, ok := node.(*prop.Node)
+		if !ok {
+			collect(node, path, ancestors, elements, findings)
+			continue
+		}
+
+		name := strings.ToLower(n.Name())
+		seen[n.Name()]++
+
+		childPath := path + "/" + name
+		if counts[n.Name()] > 1 {
+			childPath += "[" + strconv.Itoa(seen[n.Name()]) + "]"
+		}
+
+		*elements = append(*elements, element{node: n, path: childPath, ancestors: ancestors})
+		collect(n, childPath, ancestors, elements, findings)
+	}
+}
+
+// flatten returns the elements among the nodes, the ones inside a RawNode are not counted
+func flatten(nodes []prop.FakeDOM) []*prop.Node {
+	var elements []*prop.Node
+	for _, node := range nodes {
+		if n, ok := node.(*prop.Node); ok {
+			elements = append(elements, n)
+		}
+	}
+
+	return elements
+}
+
+// Check runs every rule over the tree, the findings are in document order grouped by rule
+func Check(tree prop.FakeDOM) Findings {
+	var (
+		elements []element
+		findings Findings
+	)
+	collect(tree, "", nil, &elements, &findings)
+
+	for _, rule := range []func([]element) Findings{
+		checkAlt,
+		checkLabels,
+		checkThScope,
+		checkAccessKeys,
+		checkTabIndex,
+		checkNoopener,
+		checkTrack,
+	} {
+		findings = append(findings, rule(elements)...)
+	}
+
+	return findings
+}
+
+// CheckVecty renders the vecty tree and checks it, outside of a browser the program has to import prop/native
+func CheckVecty(c vecty.ComponentOrHTML) (Findings, error) {
+	tree, err := prop.FromVecty(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return Check(tree), nil
+}
+
+func is(node *prop.Node, name string) bool {
+	return strings.EqualFold(node.Name(), name)
+}
+
+func finding(e element, rule RuleCase, attr, message string) Finding {
+	return Finding{
+		Rule:    rule,
+		Path:    e.path,
+		Element: strings.ToLower(e.node.Name()),
+		Attr:    attr,
+		Message: message,
+	}
+}
+
+func checkAlt(elements []element) Findings {
+	var findings Findings
+	for _, e := range elements {
+		if _, ok := e.attr("alt"); ok {
+			continue
+		}
+
+		switch {
+		case is(e.node, "img"):
+			findings = append(findings, finding(e, RuleCaseImgAlt, "alt", "the image has no alt text, use an empty alt for decorative images"))
+		case is(e.node, "area"):
+			if _, ok := e.attr("href"); ok {
+				findings = append(findings, finding(e, RuleCaseAreaAlt, "alt", "the link of the image map has no alt text"))
+			}
+		}
+	}
+
+	return findings
+}
+
+func checkTrack(elements []element) Findings {
+	var findings Findings
+	for _, e := range elements {
+		if !is(e.node, "track") {
+			continue
+		}
+
+		kind, _ := e.attr("kind")
+		if _, ok := e.attr("srclang"); !ok && strings.EqualFold(kind, "subtitles") {
+			findings = append(findings, finding(e, RuleCaseTrackSrcLang, "srclang", "subtitles need the language of the track"))
+		}
+	}
+
+	return findings
+}
+
+func checkTabIndex(elements []element) Findings {
+	var findings Findings
+	for _, e := range elements {
+		value, ok := e.attr("tabindex")
+		if !ok {
+			continue
+		}
+
+		if n, err := strconv.Atoi(strings.TrimSpace(value)); err == nil && n > 0 {
+			findings = append(findings, finding(e, RuleCaseTabIndex, "tabindex", "tabindex="+value+" moves the element out of the tabbing order, use 0 or -1"))
+		}
+	}
+
+	return findings
+}
+
+func checkNoopener(elements []element) Findings {
+	var findings Findings
+	for _, e := range elements {
+		if !is(e.node, "a") && !is(e.node, "area") && !is(e.node, "form") {
+			continue
+		}
+
+		target, _ := e.attr("target")
+		if !strings.EqualFold(target, "_blank") {
+			continue
+		}
+
+		rel, _ := e.attr("rel")
+		safe := false
+		for _, token := range strings.Fields(strings.ToLower(rel)) {
+			// noreferrer implies noopener
+			if token == "noopener" || token == "noreferrer" {
+				safe = true
+			}
+		}
+
+		if !safe {
+			findings = append(findings, finding(e, RuleCaseNoopener, "rel", "target=_blank gives the new page access to window.opener, add rel=noopener"))
+		}
+	}
+
+	return findings
+}
+
+func checkAccessKeys(elements []element) Findings {
+	var findings Findings
+
+	first := map[string]string{}
+	for _, e := range elements {
+		value, ok := e.attr("accesskey")
+		if !ok {
+			continue
+		}
+
+		for _, key := range strings.Fields(value) {
+			key = strings.ToLower(key)
+			if path, ok := first[key]; ok {
+				findings = append(findings, finding(e, RuleCaseAccessKey, "accesskey", "the access key "+key+" is also used by "+path))
+				continue
+			}
+
+			first[key] = e.path
+		}
+	}
+
+	return findings
+}
+
+// unlabelledTypes are the input types that are labelled by their value or don't need a label
+var unlabelledTypes = map[string]bool{
+	"hidden": true,
+	"submit": true,
+	"reset":  true,
+	"button": true,
+	"image":  true,
+}
+
+func checkLabels(elements []element) Findings {
+	labelled := map[string]bool{}
+	for _, e := range elements {
+		if id, ok := e.attr("for"); ok && is(e.node, "label") {
+			labelled[id] = true
+		}
+	}
+
+	var findings Findings
+	for _, e := range elements {
+		if !is(e.node, "input") && !is(e.node, "select") && !is(e.node, "textarea") {
+			continue
+		}
+
+		if typ, _ := e.attr("type"); is(e.node, "input") && unlabelledTypes[strings.ToLower(typ)] {
+			continue
+		}
+
+		if id, ok := e.attr("id"); ok && labelled[id] {
+			continue
+		}
+		if _, ok := e.attr("aria-label"); ok {
+			continue
+		}
+		if _, ok := e.attr("aria-labelledby"); ok {
+			continue
+		}
+
+		wrapped := false
+		for _, ancestor := range e.ancestors {
+			if is(ancestor, "label") {
+				wrapped = true
+			}
+		}
+		if wrapped {
+			continue
+		}
+
+		findings = append(findings, finding(e, RuleCaseLabel, "id", "the control has no <label for> that refers to it"))
+	}
+
+	return findings
+}
+
+func checkThScope(elements []element) Findings {
+	var findings Findings
+	for _, e := range elements {
+		if !is(e.node, "table") {
+			continue
+		}
+
+		rows := tableRows(e.node)
+		if !complexTable(rows) {
+			continue
+		}
+
+		for _, row := range rows {
+			for _, cell := range row.Nodes() {
+				th, ok := cell.(*prop.Node)
+				if !ok || !is(th, "th") {
+					continue
+				}
+
+				if _, ok := th.Attr("scope"); ok {
+					continue
+				}
+				if _, ok := th.Attr("id"); ok {
+					// the data cells refer to the header with headers
+					continue
+				}
+
+				findings = append(findings, finding(elementOf(elements, th), RuleCaseThScope, "scope", "the table has more than one level of headers, the header cell needs a scope"))
+			}
+		}
+	}
+
+	return findings
+}
+
+func elementOf(elements []element, node *prop.Node) element {
+	for _, e := range elements {
+		if e.node == node {
+			return e
+		}
+	}
+
+	return element{node: node}
+}
+
+// tableRows returns the rows of the table, nested tables are skipped
+func tableRows(table *prop.Node) []*prop.Node {
+	var rows []*prop.Node
+	for _, node := range flatten(table.Nodes()) {
+		switch {
+		case is(node, "tr"):
+			rows = append(rows, node)
+		case is(node, "thead"), is(node, "tbody"), is(node, "tfoot"):
+			for _, row := range flatten(node.Nodes()) {
+				if is(row, "tr") {
+					rows = append(rows, row)
+				}
+			}
+		}
+	}
+
+	return rows
+}
+
+// complexTable reports whether the table has headers for both rows and columns, more than one header row
+// or header cells spanning several rows or columns
+func complexTable(rows []*prop.Node) bool {
+	headerRows, rowHeaders := 0, 0
+
+	for _, row := range rows {
+		cells := flatten(row.Nodes())
+		ths := 0
+		for i, cell := range cells {
+			if !is(cell, "th") {
+				continue
+			}
+			ths++
+
+			for _, span := range []string{"colspan", "rowspan"} {
+				if value, ok := cell.Attr(span); ok {
+					if n, err := strconv.Atoi(strings.TrimSpace(value)); err == nil && n > 1 {
+						return true
+					}
+				}
+			}
+
+			if i == 0 && len(cells) > 1 && !allHeaders(cells) {
+				rowHeaders++
+			}
+		}
+
+		if ths > 0 && ths == len(cells) {
+			headerRows++
+		}
+	}
+
+	return headerRows > 1 || headerRows > 0 && rowHeaders > 0
+}
+
+func allHeaders(cells []*prop.Node) bool {
+	for _, cell := range cells {
+		if !is(cell, "th") {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,150 @@
+package a11y
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Hand-of-Doom/Vecty-Props/prop"
+	_ "github.com/Hand-of-Doom/Vecty-Props/prop/native"
+	"github.com/hexops/vecty"
+)
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name string
+		html string
+		want []RuleCase
+	}{
+		{name: "img with alt", html: `<img src="a.png" alt="a cat">`},
+		{name: "decorative img", html: `<img src="a.png" alt="">`},
+		{name: "img without alt", html: `<img src="a.png">`, want: []RuleCase{RuleCaseImgAlt}},
+		{name: "area with alt", html: `<map><area href="/a" alt="a"></map>`},
+		{name: "area without href", html: `<map><area></map>`},
+		{name: "area without alt", html: `<map><area href="/a"></map>`, want: []RuleCase{RuleCaseAreaAlt}},
+		{name: "label for", html: `<label for="a">A</label><input id="a">`},
+		{name: "wrapping label", html: `<label>A <select></select></label>`},
+		{name: "aria-label", html: `<textarea aria-label="A"></textarea>`},
+		{name: "submit", html: `<input type="submit">`},
+		{name: "unlabelled input", html: `<label for="b">B</label><input id="a">`, want: []RuleCase{RuleCaseLabel}},
+		{name: "unlabelled textarea", html: `<textarea></textarea>`, want: []RuleCase{RuleCaseLabel}},
+		{name: "simple table", html: `<table><tr><th>A</th><th>B</th></tr><tr><td>1</td><td>2</td></tr></table>`},
+		{
+			name: "complex table with scopes",
+			html: `<table><tr><th scope="col">A</th><th scope="col">B</th></tr><tr><th scope="row">1</th><td>2</td></tr></table>`,
+		},
+		{
+			name: "complex table without scopes",
+			html: `<table><tr><th colspan="2">A</th></tr><tr><td>1</td><td>2</td></tr></table>`,
+			want: []RuleCase{RuleCaseThScope},
+		},
+		{name: "distinct access keys", html: `<button accesskey="s">S</button><button accesskey="d">D</button>`},
+		{name: "duplicate access keys", html: `<button accesskey="s">S</button><a accesskey="S">A</a>`, want: []RuleCase{RuleCaseAccessKey}},
+		{name: "tabindex 0", html: `<div tabindex="0"></div><div tabindex="-1"></div>`},
+		{name: "positive tabindex", html: `<div tabindex="2"></div>`, want: []RuleCase{RuleCaseTabIndex}},
+		{name: "blank noopener", html: `<a href="/a" target="_blank" rel="noopener">A</a>`},
+		{name: "blank noreferrer", html: `<form target="_blank" rel="noreferrer"></form>`},
+		{name: "blank without rel", html: `<a href="/a" target="_blank">A</a>`, want: []RuleCase{RuleCaseNoopener}},
+		{name: "subtitles with srclang", html: `<video><track kind="subtitles" srclang="en"></video>`},
+		{name: "captions without srclang", html: `<video><track kind="captions"></video>`},
+		{name: "subtitles without srclang", html: `<video><track kind="subtitles"></video>`, want: []RuleCase{RuleCaseTrackSrcLang}},
+		{
+			name: "grouped by rule",
+			html: `<img><div tabindex="1"><img></div>`,
+			want: []RuleCase{RuleCaseImgAlt, RuleCaseImgAlt, RuleCaseTabIndex},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree, err := prop.ParseFakeDOM(strings.NewReader(tt.html))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := rules(Check(tree)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Check = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckParse(t *testing.T) {
+	tests := []struct {
+		name string
+		tree prop.FakeDOM
+		want []RuleCase
+	}{
+		{name: "raw content", tree: prop.NewRawNode(`<img alt="a"><img>`), want: []RuleCase{RuleCaseImgAlt}},
+		{name: "unparsable raw content", tree: prop.NewRawNode(`<img alt="a`), want: []RuleCase{RuleCaseParse}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rules(Check(tt.tree)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Check = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindingPath(t *testing.T) {
+	tree, err := prop.ParseFakeDOM(strings.NewReader(`<form><input id="a"><input type="hidden"><input aria-label="c"></form>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	findings := Check(tree)
+	if len(findings) != 1 || findings[0].Path != "/form/input[1]" || findings[0].Element != "input" {
+		t.Fatalf("Check = %v, want a label finding for /form/input[1]", findings)
+	}
+	if findings.Err() == nil || Findings(nil).Err() != nil {
+		t.Error("Err doesn't report whether there are findings")
+	}
+}
+
+func TestCheckVecty(t *testing.T) {
+	tests := []struct {
+		name string
+		tree vecty.ComponentOrHTML
+		want []RuleCase
+	}{
+		{
+			name: "labelled form",
+			tree: vecty.Tag("form",
+				vecty.Tag("label", vecty.Markup(prop.For(prop.NewEntityRef("email"))), vecty.Text("Email")),
+				vecty.Tag("input", vecty.Markup(prop.ID(prop.NewEntityRef("email")), prop.InputType(prop.InputTypeCaseEmail))),
+			),
+		},
+		{
+			name: "findings",
+			tree: vecty.Tag("div",
+				vecty.Tag("img", vecty.Markup(prop.Src("/a.png"))),
+				vecty.Tag("a", vecty.Markup(prop.Href("/a"), prop.Target(prop.TargetCaseBlank))),
+			),
+			want: []RuleCase{RuleCaseImgAlt, RuleCaseNoopener},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			findings, err := CheckVecty(tt.tree)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := rules(findings); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CheckVecty = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func rules(findings Findings) []RuleCase {
+	var rules []RuleCase
+	for _, f := range findings {
+		rules = append(rules, f.Rule)
+	}
+
+	return rules
+}
@@ -0,0 +1,1051 @@
+// Package aria sets the role and the aria-* states and properties of WAI-ARIA 1.2
+// and checks which of them a role allows and requires
+package aria
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Hand-of-Doom/Vecty-Props/prop"
+	"github.com/hexops/vecty"
+)
+
+func boolValue(flag bool) string {
+	return strconv.FormatBool(flag)
+}
+
+func attr(name, value string) vecty.Applyer {
+	return vecty.Attribute(name, value)
+}
+
+// badKeyword reports a value that is none of the keywords, they are a slice of the type of the attribute
+func badKeyword(attr, value string, keywords interface{}) error {
+	return &prop.ValueError{Attr: attr, Value: value, Reason: "expected one of " + strings.Trim(fmt.Sprint(keywords), "[]")}
+}
+
+// TristateCase is a true/false value with the third mixed state
+type TristateCase string
+
+const (
+	TristateCaseFalse TristateCase = "false"
+	TristateCaseTrue  TristateCase = "true"
+	TristateCaseMixed TristateCase = "mixed"
+)
+
+func (c TristateCase) Valid() bool {
+	for _, v := range TristateCaseValues() {
+		if c == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c TristateCase) String() string {
+	return string(c)
+}
+
+func (c TristateCase) check(attr string) error {
+	if c.Valid() {
+		return nil
+	}
+
+	return badKeyword(attr, string(c), TristateCaseValues())
+}
+
+// TristateCaseValues returns the keywords of aria-checked or aria-pressed
+func TristateCaseValues() []TristateCase {
+	return []TristateCase{TristateCaseFalse, TristateCaseTrue, TristateCaseMixed}
+}
+
+// ParseTristateCase returns the keyword that matches the value ignoring case
+func ParseTristateCase(value string) (TristateCase, error) {
+	for _, c := range TristateCaseValues() {
+		if strings.EqualFold(string(c), value) {
+			return c, nil
+		}
+	}
+
+	return "", badKeyword("aria-checked or aria-pressed", value, TristateCaseValues())
+}
+
+// Tristate converts a flag to TristateCaseTrue or TristateCaseFalse
+func Tristate(flag bool) TristateCase {
+	if flag {
+		return TristateCaseTrue
+	}
+
+	return TristateCaseFalse
+}
+
+// BoolCase is a true/false value that can be left undefined, the element then has no such state,
+// an expanded state that doesn't apply is not the same as a collapsed one
+type BoolCase string
+
+const (
+	BoolCaseFalse     BoolCase = "false"
+	BoolCaseTrue      BoolCase = "true"
+	BoolCaseUndefined BoolCase = "undefined"
+)
+
+func (c BoolCase) Valid() bool {
+	for _, v := range BoolCaseValues() {
+		if c == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c BoolCase) String() string {
+	return string(c)
+}
+
+func (c BoolCase) check(attr string) error {
+	if c.Valid() {
+		return nil
+	}
+
+	return badKeyword(attr, string(c), BoolCaseValues())
+}
+
+// BoolCaseValues returns the keywords of aria-expanded, aria-hidden or aria-selected
+func BoolCaseValues() []BoolCase {
+	return []BoolCase{BoolCaseFalse, BoolCaseTrue, BoolCaseUndefined}
+}
+
+// ParseBoolCase returns the keyword that matches the value ignoring case
+func ParseBoolCase(value string) (BoolCase, error) {
+	for _, c := range BoolCaseValues() {
+		if strings.EqualFold(string(c), value) {
+			return c, nil
+		}
+	}
+
+	return "", badKeyword("aria-expanded, aria-hidden or aria-selected", value, BoolCaseValues())
+}
+
+// Bool converts a flag to BoolCaseTrue or BoolCaseFalse
+func Bool(flag bool) BoolCase {
+	if flag {
+		return BoolCaseTrue
+	}
+
+	return BoolCaseFalse
+}
+
+type AutocompleteCase string
+
+const (
+	AutocompleteCaseInline AutocompleteCase = "inline"
+	AutocompleteCaseList   AutocompleteCase = "list"
+	AutocompleteCaseBoth   AutocompleteCase = "both"
+	AutocompleteCaseNone   AutocompleteCase = "none"
+)
+
+func (c AutocompleteCase) Valid() bool {
+	for _, v := range AutocompleteCaseValues() {
+		if c == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c AutocompleteCase) String() string {
+	return string(c)
+}
+
+func (c AutocompleteCase) check(attr string) error {
+	if c.Valid() {
+		return nil
+	}
+
+	return badKeyword(attr, string(c), AutocompleteCaseValues())
+}
+
+// AutocompleteCaseValues returns the keywords of aria-autocomplete
+func AutocompleteCaseValues() []AutocompleteCase {
+	return []AutocompleteCase{AutocompleteCaseInline, AutocompleteCaseList, AutocompleteCaseBoth, AutocompleteCaseNone}
+}
+
+// ParseAutocompleteCase returns the keyword that matches the value ignoring case
+func ParseAutocompleteCase(value string) (AutocompleteCase, error) {
+	for _, c := range AutocompleteCaseValues() {
+		if strings.EqualFold(string(c), value) {
+			return c, nil
+		}
+	}
+
+	return "", badKeyword("aria-autocomplete", value, AutocompleteCaseValues())
+}
+
+type CurrentCase string
+
+const (
+	CurrentCasePage     CurrentCase = "page"
+	CurrentCaseStep     CurrentCase = "step"
+	CurrentCaseLocation CurrentCase = "location"
+	CurrentCaseDate     CurrentCase = "date"
+	CurrentCaseTime     CurrentCase = "time"
+	CurrentCaseTrue     CurrentCase = "true"
+	CurrentCaseFalse    CurrentCase = "false"
+)
+
+func (c CurrentCase) Valid() bool {
+	for _, v := range CurrentCaseValues() {
+		if c == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c CurrentCase) String() string {
+	return string(c)
+}
+
+func (c CurrentCase) check(attr string) error {
+	if c.Valid() {
+		return nil
+	}
+
+	return badKeyword(attr, string(c), CurrentCaseValues())
+}
+
+// CurrentCaseValues returns the keywords of aria-current
+func CurrentCaseValues() []CurrentCase {
+	return []CurrentCase{CurrentCasePage, CurrentCaseStep, CurrentCaseLocation, CurrentCaseDate, CurrentCaseTime, CurrentCaseTrue, CurrentCaseFalse}
+}
+
+// ParseCurrentCase returns the keyword that matches the value ignoring case
+func ParseCurrentCase(value string) (CurrentCase, error) {
+	for _, c := range CurrentCaseValues() {
+		if strings.EqualFold(string(c), value) {
+			return c, nil
+		}
+	}
+
+	return "", badKeyword("aria-current", value, CurrentCaseValues())
+}
+
+// DropEffectCase applies to aria-dropeffect
+//
+// Deprecated: aria-dropeffect is deprecated in ARIA 1.1
+type DropEffectCase string
+
+const (
+	DropEffectCaseCopy    DropEffectCase = "copy"
+	DropEffectCaseExecute DropEffectCase = "execute"
+	DropEffectCaseLink    DropEffectCase = "link"
+	DropEffectCaseMove    DropEffectCase = "move"
+	DropEffectCaseNone    DropEffectCase = "none"
+	DropEffectCasePopup   DropEffectCase = "popup"
+)
+
+func (c DropEffectCase) Valid() bool {
+	for _, v := range DropEffectCaseValues() {
+		if c == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c DropEffectCase) String() string {
+	return string(c)
+}
+
+func (c DropEffectCase) check(attr string) error {
+	if c.Valid() {
+		return nil
+	}
+
+	return badKeyword(attr, string(c), DropEffectCaseValues())
+}
+
+// DropEffectCaseValues returns the keywords of aria-dropeffect
+func DropEffectCaseValues() []DropEffectCase {
+	return []DropEffectCase{DropEffectCaseCopy, DropEffectCaseExecute, DropEffectCaseLink, DropEffectCaseMove, DropEffectCaseNone, DropEffectCasePopup}
+}
+
+// ParseDropEffectCase returns the keyword that matches the value ignoring case
+func ParseDropEffectCase(value string) (DropEffectCase, error) {
+	for _, c := range DropEffectCaseValues() {
+		if strings.EqualFold(string(c), value) {
+			return c, nil
+		}
+	}
+
+	return "", badKeyword("aria-dropeffect", value, DropEffectCaseValues())
+}
+
+type HasPopupCase string
+
+const (
+	HasPopupCaseFalse   HasPopupCase = "false"
+	HasPopupCaseTrue    HasPopupCase = "true"
+	HasPopupCaseMenu    HasPopupCase = "menu"
+	HasPopupCaseListbox HasPopupCase = "listbox"
+	HasPopupCaseTree    HasPopupCase = "tree"
+	HasPopupCaseGrid    HasPopupCase = "grid"
+	HasPopupCaseDialog  HasPopupCase = "dialog"
+)
+
+func (c HasPopupCase) Valid() bool {
+	for _, v := range HasPopupCaseValues() {
+		if c == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c HasPopupCase) String() string {
+	return string(c)
+}
+
+func (c HasPopupCase) check(attr string) error {
+	if c.Valid() {
+		return nil
+	}
+
+	return badKeyword(attr, string(c), HasPopupCaseValues())
+}
+
+// HasPopupCaseValues returns the keywords of aria-haspopup
+func HasPopupCaseValues() []HasPopupCase {
+	return []HasPopupCase{HasPopupCaseFalse, HasPopupCaseTrue, HasPopupCaseMenu, HasPopupCaseListbox, HasPopupCaseTree, HasPopupCaseGrid, HasPopupCaseDialog}
+}
+
+// ParseHasPopupCase returns the keyword that matches the value ignoring case
+func ParseHasPopupCase(value string) (HasPopupCase, error) {
+	for _, c := range HasPopupCaseValues() {
+		if strings.EqualFold(string(c), value) {
+			return c, nil
+		}
+	}
+
+	return "", badKeyword("aria-haspopup", value, HasPopupCaseValues())
+}
+
+type InvalidCase string
+
+const (
+	InvalidCaseFalse    InvalidCase = "false"
+	InvalidCaseTrue     InvalidCase = "true"
+	InvalidCaseGrammar  InvalidCase = "grammar"
+	InvalidCaseSpelling InvalidCase = "spelling"
+)
+
+func (c InvalidCase) Valid() bool {
+	for _, v := range InvalidCaseValues() {
+		if c == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c InvalidCase) String() string {
+	return string(c)
+}
+
+func (c InvalidCase) check(attr string) error {
+	if c.Valid() {
+		return nil
+	}
+
+	return badKeyword(attr, string(c), InvalidCaseValues())
+}
+
+// InvalidCaseValues returns the keywords of aria-invalid
+func InvalidCaseValues() []InvalidCase {
+	return []InvalidCase{InvalidCaseFalse, InvalidCaseTrue, InvalidCaseGrammar, InvalidCaseSpelling}
+}
+
+// ParseInvalidCase returns the keyword that matches the value ignoring case
+func ParseInvalidCase(value string) (InvalidCase, error) {
+	for _, c := range InvalidCaseValues() {
+		if strings.EqualFold(string(c), value) {
+			return c, nil
+		}
+	}
+
+	return "", badKeyword("aria-invalid", value, InvalidCaseValues())
+}
+
+type LiveCase string
+
+const (
+	LiveCaseOff       LiveCase = "off"
+	LiveCasePolite    LiveCase = "polite"
+	LiveCaseAssertive LiveCase = "assertive"
+)
+
+func (c LiveCase) Valid() bool {
+	for _, v := range LiveCaseValues() {
+		if c == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c LiveCase) String() string {
+	return string(c)
+}
+
+func (c LiveCase) check(attr string) error {
+	if c.Valid() {
+		return nil
+	}
+
+	return badKeyword(attr, string(c), LiveCaseValues())
+}
+
+// LiveCaseValues returns the keywords of aria-live
+func LiveCaseValues() []LiveCase {
+	return []LiveCase{LiveCaseOff, LiveCasePolite, LiveCaseAssertive}
+}
+
+// ParseLiveCase returns the keyword that matches the value ignoring case
+func ParseLiveCase(value string) (LiveCase, error) {
+	for _, c := range LiveCaseValues() {
+		if strings.EqualFold(string(c), value) {
+			return c, nil
+		}
+	}
+
+	return "", badKeyword("aria-live", value, LiveCaseValues())
+}
+
+type OrientationCase string
+
+const (
+	OrientationCaseHorizontal OrientationCase = "horizontal"
+	OrientationCaseVertical   OrientationCase = "vertical"
+	OrientationCaseUndefined  OrientationCase = "undefined"
+)
+
+func (c OrientationCase) Valid() bool {
+	for _, v := range OrientationCaseValues() {
+		if c == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c OrientationCase) String() string {
+	return string(c)
+}
+
+func (c OrientationCase) check(attr string) error {
+	if c.Valid() {
+		return nil
+	}
+
+	return badKeyword(attr, string(c), OrientationCaseValues())
+}
+
+// OrientationCaseValues returns the keywords of aria-orientation
+func OrientationCaseValues() []OrientationCase {
+	return []OrientationCase{OrientationCaseHorizontal, OrientationCaseVertical, OrientationCaseUndefined}
+}
+
+// ParseOrientationCase returns the keyword that matches the value ignoring case
+func ParseOrientationCase(value string) (OrientationCase, error) {
+	for _, c := range OrientationCaseValues() {
+		if strings.EqualFold(string(c), value) {
+			return c, nil
+		}
+	}
+
+	return "", badKeyword("aria-orientation", value, OrientationCaseValues())
+}
+
+type RelevantCase string
+
+const (
+	RelevantCaseAdditions RelevantCase = "additions"
+	RelevantCaseRemovals  RelevantCase = "removals"
+	RelevantCaseText      RelevantCase = "text"
+	RelevantCaseAll       RelevantCase = "all"
+)
+
+func (c RelevantCase) Valid() bool {
+	for _, v := range RelevantCaseValues() {
+		if c == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c RelevantCase) String() string {
+	return string(c)
+}
+
+func (c RelevantCase) check(attr string) error {
+	if c.Valid() {
+		return nil
+	}
+
+	return badKeyword(attr, string(c), RelevantCaseValues())
+}
+
+// RelevantCaseValues returns the keywords of aria-relevant
+func RelevantCaseValues() []RelevantCase {
+	return []RelevantCase{RelevantCaseAdditions, RelevantCaseRemovals, RelevantCaseText, RelevantCaseAll}
+}
+
+// ParseRelevantCase returns the keyword that matches the value ignoring case
+func ParseRelevantCase(value string) (RelevantCase, error) {
+	for _, c := range RelevantCaseValues() {
+		if strings.EqualFold(string(c), value) {
+			return c, nil
+		}
+	}
+
+	return "", badKeyword("aria-relevant", value, RelevantCaseValues())
+}
+
+type SortCase string
+
+const (
+	SortCaseAscending  SortCase = "ascending"
+	SortCaseDescending SortCase = "descending"
+	SortCaseNone       SortCase = "none"
+	SortCaseOther      SortCase = "other"
+)
+
+func (c SortCase) Valid() bool {
+	for _, v := range SortCaseValues() {
+		if c == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c SortCase) String() string {
+	return string(c)
+}
+
+func (c SortCase) check(attr string) error {
+	if c.Valid() {
+		return nil
+	}
+
+	return badKeyword(attr, string(c), SortCaseValues())
+}
+
+// SortCaseValues returns the keywords of aria-sort
+func SortCaseValues() []SortCase {
+	return []SortCase{SortCaseAscending, SortCaseDescending, SortCaseNone, SortCaseOther}
+}
+
+// ParseSortCase returns the keyword that matches the value ignoring case
+func ParseSortCase(value string) (SortCase, error) {
+	for _, c := range SortCaseValues() {
+		if strings.EqualFold(string(c), value) {
+			return c, nil
+		}
+	}
+
+	return "", badKeyword("aria-sort", value, SortCaseValues())
+}
+
+// Role specifies the ARIA role of the element, it panics when the role is not valid, see RoleE
+func Role(c RoleCase) vecty.Applyer {
+	applyer, err := RoleE(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// RoleE is Role that returns an error instead of panicking
+func RoleE(c RoleCase) (vecty.Applyer, error) {
+	if err := c.check(); err != nil {
+		return nil, err
+	}
+
+	return attr("role", string(c)), nil
+}
+
+// ActiveDescendant identifies the focused descendant of a composite widget
+func ActiveDescendant(value prop.EntityRef) vecty.Applyer {
+	return prop.IDRefs("aria-activedescendant", value)
+}
+
+// Atomic specifies whether assistive technologies present the whole live region when a part of it changes
+func Atomic(flag bool) vecty.Applyer {
+	return attr("aria-atomic", boolValue(flag))
+}
+
+// Autocomplete specifies how the input suggests completions, it panics when the value is not valid, see AutocompleteE
+func Autocomplete(c AutocompleteCase) vecty.Applyer {
+	applyer, err := AutocompleteE(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// AutocompleteE is Autocomplete that returns an error instead of panicking
+func AutocompleteE(c AutocompleteCase) (vecty.Applyer, error) {
+	if err := c.check("aria-autocomplete"); err != nil {
+		return nil, err
+	}
+
+	return attr("aria-autocomplete", string(c)), nil
+}
+
+// Busy specifies that the element is being modified
+func Busy(flag bool) vecty.Applyer {
+	return attr("aria-busy", boolValue(flag))
+}
+
+// Checked specifies the checked state of checkboxes, radio buttons and other widgets,
+// it panics when the value is not valid, see CheckedE
+func Checked(c TristateCase) vecty.Applyer {
+	applyer, err := CheckedE(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// CheckedE is Checked that returns an error instead of panicking
+func CheckedE(c TristateCase) (vecty.Applyer, error) {
+	if err := c.check("aria-checked"); err != nil {
+		return nil, err
+	}
+
+	return attr("aria-checked", string(c)), nil
+}
+
+// ColCount specifies the number of columns in a table, grid or treegrid, -1 means unknown
+func ColCount(value int64) vecty.Applyer {
+	return attr("aria-colcount", strconv.FormatInt(value, 10))
+}
+
+// ColIndex specifies the column index of the element in a table, grid or treegrid, it starts from 1
+func ColIndex(value uint64) vecty.Applyer {
+	return attr("aria-colindex", strconv.FormatUint(value, 10))
+}
+
+// ColSpan specifies the number of columns spanned by the cell
+func ColSpan(value uint64) vecty.Applyer {
+	return attr("aria-colspan", strconv.FormatUint(value, 10))
+}
+
+// Controls identifies the elements whose contents or presence are controlled by the element
+func Controls(values ...prop.EntityRef) vecty.Applyer {
+	return prop.IDRefs("aria-controls", values...)
+}
+
+// Current specifies the current item within a set of related elements,
+// it panics when the value is not valid, see CurrentE
+func Current(c CurrentCase) vecty.Applyer {
+	applyer, err := CurrentE(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// CurrentE is Current that returns an error instead of panicking
+func CurrentE(c CurrentCase) (vecty.Applyer, error) {
+	if err := c.check("aria-current"); err != nil {
+		return nil, err
+	}
+
+	return attr("aria-current", string(c)), nil
+}
+
+// DescribedBy identifies the elements that describe the element
+func DescribedBy(values ...prop.EntityRef) vecty.Applyer {
+	return prop.IDRefs("aria-describedby", values...)
+}
+
+// Details identifies the element that provides an extended description
+func Details(value prop.EntityRef) vecty.Applyer {
+	return prop.IDRefs("aria-details", value)
+}
+
+// Disabled specifies that the element is perceivable but not operable
+func Disabled(flag bool) vecty.Applyer {
+	return attr("aria-disabled", boolValue(flag))
+}
+
+// DropEffect specifies what happens when the dragged object is released on the drop target,
+// it panics when a value is not valid, see DropEffectE
+//
+// Deprecated: aria-dropeffect is deprecated in ARIA 1.1
+func DropEffect(values ...DropEffectCase) vecty.Applyer {
+	applyer, err := DropEffectE(values...)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// DropEffectE is DropEffect that returns an error instead of panicking
+//
+// Deprecated: aria-dropeffect is deprecated in ARIA 1.1
+func DropEffectE(values ...DropEffectCase) (vecty.Applyer, error) {
+	tokens := make([]string, 0, len(values))
+	for _, value := range values {
+		if err := value.check("aria-dropeffect"); err != nil {
+			return nil, err
+		}
+
+		tokens = append(tokens, string(value))
+	}
+
+	return attr("aria-dropeffect", strings.Join(tokens, " ")), nil
+}
+
+// ErrorMessage identifies the element that provides the error message of the element
+func ErrorMessage(value prop.EntityRef) vecty.Applyer {
+	return prop.IDRefs("aria-errormessage", value)
+}
+
+// Expanded specifies whether the grouping element owned or controlled by the element is expanded,
+// undefined means that the element doesn't control one, it panics when the value is not valid, see ExpandedE
+func Expanded(c BoolCase) vecty.Applyer {
+	applyer, err := ExpandedE(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// ExpandedE is Expanded that returns an error instead of panicking
+func ExpandedE(c BoolCase) (vecty.Applyer, error) {
+	if err := c.check("aria-expanded"); err != nil {
+		return nil, err
+	}
+
+	return attr("aria-expanded", string(c)), nil
+}
+
+// FlowTo identifies the next elements in an alternate reading order
+func FlowTo(values ...prop.EntityRef) vecty.Applyer {
+	return prop.IDRefs("aria-flowto", values...)
+}
+
+// Grabbed specifies the grabbed state of the element in a drag-and-drop operation
+//
+// Deprecated: aria-grabbed is deprecated in ARIA 1.1
+func Grabbed(flag bool) vecty.Applyer {
+	return attr("aria-grabbed", boolValue(flag))
+}
+
+// HasPopup specifies the kind of the popup the element can trigger,
+// it panics when the value is not valid, see HasPopupE
+func HasPopup(c HasPopupCase) vecty.Applyer {
+	applyer, err := HasPopupE(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// HasPopupE is HasPopup that returns an error instead of panicking
+func HasPopupE(c HasPopupCase) (vecty.Applyer, error) {
+	if err := c.check("aria-haspopup"); err != nil {
+		return nil, err
+	}
+
+	return attr("aria-haspopup", string(c)), nil
+}
+
+// Hidden specifies that the element is not exposed to the accessibility API,
+// undefined leaves it to the user agent, it panics when the value is not valid, see HiddenE
+func Hidden(c BoolCase) vecty.Applyer {
+	applyer, err := HiddenE(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// HiddenE is Hidden that returns an error instead of panicking
+func HiddenE(c BoolCase) (vecty.Applyer, error) {
+	if err := c.check("aria-hidden"); err != nil {
+		return nil, err
+	}
+
+	return attr("aria-hidden", string(c)), nil
+}
+
+// Invalid specifies that the entered value does not conform to the expected format,
+// it panics when the value is not valid, see InvalidE
+func Invalid(c InvalidCase) vecty.Applyer {
+	applyer, err := InvalidE(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// InvalidE is Invalid that returns an error instead of panicking
+func InvalidE(c InvalidCase) (vecty.Applyer, error) {
+	if err := c.check("aria-invalid"); err != nil {
+		return nil, err
+	}
+
+	return attr("aria-invalid", string(c)), nil
+}
+
+// KeyShortcuts specifies the keyboard shortcuts that activate or focus the element
+// ex: Control+Alt+P
+func KeyShortcuts(value string) vecty.Applyer {
+	return attr("aria-keyshortcuts", value)
+}
+
+// Label specifies the accessible name of the element
+func Label(value string) vecty.Applyer {
+	return attr("aria-label", value)
+}
+
+// LabelledBy identifies the elements that label the element
+func LabelledBy(values ...prop.EntityRef) vecty.Applyer {
+	return prop.IDRefs("aria-labelledby", values...)
+}
+
+// Level specifies the hierarchical level of the element, it starts from 1
+func Level(value uint64) vecty.Applyer {
+	return attr("aria-level", strconv.FormatUint(value, 10))
+}
+
+// Live specifies how the updates of the element are announced, it panics when the value is not valid, see LiveE
+func Live(c LiveCase) vecty.Applyer {
+	applyer, err := LiveE(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// LiveE is Live that returns an error instead of panicking
+func LiveE(c LiveCase) (vecty.Applyer, error) {
+	if err := c.check("aria-live"); err != nil {
+		return nil, err
+	}
+
+	return attr("aria-live", string(c)), nil
+}
+
+// Modal specifies whether the element is modal when displayed
+func Modal(flag bool) vecty.Applyer {
+	return attr("aria-modal", boolValue(flag))
+}
+
+// Multiline specifies whether the textbox accepts multiple lines
+func Multiline(flag bool) vecty.Applyer {
+	return attr("aria-multiline", boolValue(flag))
+}
+
+// Multiselectable specifies that more than one descendant can be selected
+func Multiselectable(flag bool) vecty.Applyer {
+	return attr("aria-multiselectable", boolValue(flag))
+}
+
+// Orientation specifies whether the element is horizontal or vertical,
+// it panics when the value is not valid, see OrientationE
+func Orientation(c OrientationCase) vecty.Applyer {
+	applyer, err := OrientationE(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// OrientationE is Orientation that returns an error instead of panicking
+func OrientationE(c OrientationCase) (vecty.Applyer, error) {
+	if err := c.check("aria-orientation"); err != nil {
+		return nil, err
+	}
+
+	return attr("aria-orientation", string(c)), nil
+}
+
+// Owns identifies the elements that are children of the element but are not its DOM descendants
+func Owns(values ...prop.EntityRef) vecty.Applyer {
+	return prop.IDRefs("aria-owns", values...)
+}
+
+// Placeholder specifies a short hint when the textbox has no value
+func Placeholder(value string) vecty.Applyer {
+	return attr("aria-placeholder", value)
+}
+
+// PosInSet specifies the position of the element in its set, it starts from 1
+func PosInSet(value uint64) vecty.Applyer {
+	return attr("aria-posinset", strconv.FormatUint(value, 10))
+}
+
+// Pressed specifies the pressed state of a toggle button, it panics when the value is not valid, see PressedE
+func Pressed(c TristateCase) vecty.Applyer {
+	applyer, err := PressedE(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// PressedE is Pressed that returns an error instead of panicking
+func PressedE(c TristateCase) (vecty.Applyer, error) {
+	if err := c.check("aria-pressed"); err != nil {
+		return nil, err
+	}
+
+	return attr("aria-pressed", string(c)), nil
+}
+
+// ReadOnly specifies that the element is not editable but is operable
+func ReadOnly(flag bool) vecty.Applyer {
+	return attr("aria-readonly", boolValue(flag))
+}
+
+// Relevant specifies which changes of the live region are announced, it panics when a value is not valid, see RelevantE
+func Relevant(values ...RelevantCase) vecty.Applyer {
+	applyer, err := RelevantE(values...)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// RelevantE is Relevant that returns an error instead of panicking
+func RelevantE(values ...RelevantCase) (vecty.Applyer, error) {
+	tokens := make([]string, 0, len(values))
+	for _, value := range values {
+		if err := value.check("aria-relevant"); err != nil {
+			return nil, err
+		}
+
+		tokens = append(tokens, string(value))
+	}
+
+	return attr("aria-relevant", strings.Join(tokens, " ")), nil
+}
+
+// Required specifies that user input is required before the form is submitted
+func Required(flag bool) vecty.Applyer {
+	return attr("aria-required", boolValue(flag))
+}
+
+// RoleDescription specifies a human-readable description of the role of the element
+func RoleDescription(value string) vecty.Applyer {
+	return attr("aria-roledescription", value)
+}
+
+// RowCount specifies the number of rows in a table, grid or treegrid, -1 means unknown
+func RowCount(value int64) vecty.Applyer {
+	return attr("aria-rowcount", strconv.FormatInt(value, 10))
+}
+
+// RowIndex specifies the row index of the element in a table, grid or treegrid, it starts from 1
+func RowIndex(value uint64) vecty.Applyer {
+	return attr("aria-rowindex", strconv.FormatUint(value, 10))
+}
+
+// RowSpan specifies the number of rows spanned by the cell
+func RowSpan(value uint64) vecty.Applyer {
+	return attr("aria-rowspan", strconv.FormatUint(value, 10))
+}
+
+// Selected specifies the selected state of the element, undefined means that the element is not selectable,
+// it panics when the value is not valid, see SelectedE
+func Selected(c BoolCase) vecty.Applyer {
+	applyer, err := SelectedE(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// SelectedE is Selected that returns an error instead of panicking
+func SelectedE(c BoolCase) (vecty.Applyer, error) {
+	if err := c.check("aria-selected"); err != nil {
+		return nil, err
+	}
+
+	return attr("aria-selected", string(c)), nil
+}
+
+// SetSize specifies the number of items in the set, -1 means unknown
+func SetSize(value int64) vecty.Applyer {
+	return attr("aria-setsize", strconv.FormatInt(value, 10))
+}
+
+// Sort specifies the sort order of the table or grid column, it panics when the value is not valid, see SortE
+func Sort(c SortCase) vecty.Applyer {
+	applyer, err := SortE(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// SortE is Sort that returns an error instead of panicking
+func SortE(c SortCase) (vecty.Applyer, error) {
+	if err := c.check("aria-sort"); err != nil {
+		return nil, err
+	}
+
+	return attr("aria-sort", string(c)), nil
+}
+
+// ValueMax specifies the maximum value of a range widget
+func ValueMax(value float64) vecty.Applyer {
+	return attr("aria-valuemax", strconv.FormatFloat(value, 'f', -1, 64))
+}
+
+// ValueMin specifies the minimum value of a range widget
+func ValueMin(value float64) vecty.Applyer {
+	return attr("aria-valuemin", strconv.FormatFloat(value, 'f', -1, 64))
+}
+
+// ValueNow specifies the current value of a range widget
+func ValueNow(value float64) vecty.Applyer {
+	return attr("aria-valuenow", strconv.FormatFloat(value, 'f', -1, 64))
+}
+
+// ValueText specifies the human-readable text of the current value of a range widget
+func ValueText(value string) vecty.Applyer {
+	return attr("aria-valuetext", value)
+}
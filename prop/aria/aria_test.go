@@ -0,0 +1,202 @@
+package aria
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Hand-of-Doom/Vecty-Props/prop"
+	_ "github.com/Hand-of-Doom/Vecty-Props/prop/native"
+	"github.com/hexops/vecty"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name  string
+		parse func(value string) (string, error)
+		value string
+		want  string
+		err   bool
+	}{
+		{name: "tristate", parse: func(v string) (string, error) { c, err := ParseTristateCase(v); return string(c), err }, value: "Mixed", want: "mixed"},
+		{name: "bool", parse: func(v string) (string, error) { c, err := ParseBoolCase(v); return string(c), err }, value: "undefined", want: "undefined"},
+		{name: "bad bool", parse: func(v string) (string, error) { c, err := ParseBoolCase(v); return string(c), err }, value: "mixed", err: true},
+		{name: "current", parse: func(v string) (string, error) { c, err := ParseCurrentCase(v); return string(c), err }, value: "PAGE", want: "page"},
+		{name: "haspopup", parse: func(v string) (string, error) { c, err := ParseHasPopupCase(v); return string(c), err }, value: "dialog", want: "dialog"},
+		{name: "live", parse: func(v string) (string, error) { c, err := ParseLiveCase(v); return string(c), err }, value: "loud", err: true},
+		{name: "sort", parse: func(v string) (string, error) { c, err := ParseSortCase(v); return string(c), err }, value: "descending", want: "descending"},
+		{name: "role", parse: func(v string) (string, error) { c, err := ParseRoleCase(v); return string(c), err }, value: "TabList", want: "tablist"},
+		{name: "abstract role", parse: func(v string) (string, error) { c, err := ParseRoleCase(v); return string(c), err }, value: "widget", err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parse(tt.value)
+			if (err != nil) != tt.err {
+				t.Fatalf("Parse error = %v, want error %v", err, tt.err)
+			}
+			if got != tt.want {
+				t.Errorf("Parse = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValues(t *testing.T) {
+	for _, c := range RoleCaseValues() {
+		if !c.Valid() {
+			t.Errorf("RoleCaseValues has the invalid role %s", c)
+		}
+	}
+	for _, c := range RelevantCaseValues() {
+		if parsed, err := ParseRelevantCase(c.String()); err != nil || parsed != c {
+			t.Errorf("ParseRelevantCase(%s) = %s, %v", c, parsed, err)
+		}
+	}
+
+	if AutocompleteCase("maybe").Valid() {
+		t.Error("AutocompleteCase takes maybe")
+	}
+}
+
+func TestBoolCase(t *testing.T) {
+	tests := []struct {
+		applyer vecty.Applyer
+		want    string
+	}{
+		{applyer: Expanded(Bool(true)), want: `aria-expanded="true"`},
+		{applyer: Hidden(BoolCaseFalse), want: `aria-hidden="false"`},
+		{applyer: Selected(BoolCaseUndefined), want: `aria-selected="undefined"`},
+	}
+
+	for _, tt := range tests {
+		var html strings.Builder
+		if err := prop.RenderHTML(&html, vecty.Tag("div", vecty.Markup(tt.applyer))); err != nil {
+			t.Fatal(err)
+		}
+
+		if got := html.String(); !strings.Contains(got, tt.want) {
+			t.Errorf("got %s, want %s", got, tt.want)
+		}
+	}
+}
+
+func TestCheckedHelperE(t *testing.T) {
+	tests := []struct {
+		name  string
+		build func() (vecty.Applyer, error)
+		err   bool
+	}{
+		{name: "checked", build: func() (vecty.Applyer, error) { return CheckedE(TristateCaseMixed) }},
+		{name: "bad checked", build: func() (vecty.Applyer, error) { return CheckedE("garbage") }, err: true},
+		{name: "current", build: func() (vecty.Applyer, error) { return CurrentE(CurrentCasePage) }},
+		{name: "bad current", build: func() (vecty.Applyer, error) { return CurrentE("PAGE") }, err: true},
+		{name: "hidden", build: func() (vecty.Applyer, error) { return HiddenE(Bool(true)) }},
+		{name: "bad hidden", build: func() (vecty.Applyer, error) { return HiddenE("mixed") }, err: true},
+		{name: "relevant", build: func() (vecty.Applyer, error) { return RelevantE(RelevantCaseAdditions, RelevantCaseText) }},
+		{name: "bad relevant", build: func() (vecty.Applyer, error) { return RelevantE(RelevantCaseAdditions, "changes") }, err: true},
+		{name: "role", build: func() (vecty.Applyer, error) { return RoleE(RoleCaseTab) }},
+		{name: "abstract role", build: func() (vecty.Applyer, error) { return RoleE("widget") }, err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			applyer, err := tt.build()
+			if (err != nil) != tt.err {
+				t.Fatalf("error = %v, want error %v", err, tt.err)
+			}
+			if (applyer == nil) != tt.err {
+				t.Errorf("applyer = %v, want one only without an error", applyer)
+			}
+		})
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Checked doesn't panic on a value that is not valid")
+		}
+	}()
+	Checked("garbage")
+}
+
+func TestRoleCheck(t *testing.T) {
+	tests := []struct {
+		name       string
+		role       RoleCase
+		attrs      []string
+		missing    []string
+		disallowed []string
+		err        bool
+	}{
+		{name: "checkbox", role: RoleCaseCheckbox, attrs: []string{"aria-checked", "aria-required"}},
+		{name: "checkbox without aria-checked", role: RoleCaseCheckbox, missing: []string{"aria-checked"}},
+		{name: "combobox", role: RoleCaseCombobox, attrs: []string{"aria-controls"}, missing: []string{"aria-expanded"}},
+		{name: "heading", role: RoleCaseHeading, attrs: []string{"aria-level", "aria-describedby"}},
+		{name: "global attributes", role: RoleCaseNote, attrs: []string{"aria-hidden", "aria-live", "aria-label", "aria-current"}},
+		{name: "state of another role", role: RoleCaseButton, attrs: []string{"aria-checked"}, disallowed: []string{"aria-checked"}},
+		{name: "prohibited name", role: RoleCaseGeneric, attrs: []string{"aria-label", "aria-labelledby"}, disallowed: []string{"aria-label", "aria-labelledby"}},
+		{
+			name: "missing and disallowed", role: RoleCaseSlider, attrs: []string{"aria-checked", "aria-valuemin"},
+			missing: []string{"aria-valuenow"}, disallowed: []string{"aria-checked"},
+		},
+		{name: "unknown role", role: "widget", err: true},
+		{name: "role in upper case", role: "Button", err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.role.Check(tt.attrs...)
+			if tt.missing == nil && tt.disallowed == nil && !tt.err {
+				if err != nil {
+					t.Errorf("Check = %v, want nil", err)
+				}
+				return
+			}
+
+			var roleErr *RoleError
+			if !errors.As(err, &roleErr) {
+				t.Fatalf("Check error = %v, want a RoleError", err)
+			}
+			if !reflect.DeepEqual(roleErr.Missing, tt.missing) || !reflect.DeepEqual(roleErr.Disallowed, tt.disallowed) {
+				t.Errorf("Check missing %v, disallowed %v, want %v and %v", roleErr.Missing, roleErr.Disallowed, tt.missing, tt.disallowed)
+			}
+		})
+	}
+}
+
+func TestRoleAllows(t *testing.T) {
+	tests := []struct {
+		role  RoleCase
+		attr  string
+		allow bool
+	}{
+		{role: RoleCaseCheckbox, attr: "aria-checked", allow: true},
+		{role: RoleCaseCheckbox, attr: "aria-describedby", allow: true},
+		{role: RoleCaseCheckbox, attr: "aria-valuenow"},
+		{role: RoleCaseImg, attr: "aria-label", allow: true},
+		{role: RoleCasePresentation, attr: "aria-label"},
+		{role: RoleCasePresentation, attr: "aria-hidden", allow: true},
+		{role: "widget", attr: "aria-hidden"},
+		{role: RoleCaseButton, attr: "aria-unknown"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.role.Allows(tt.attr); got != tt.allow {
+			t.Errorf("%s.Allows(%s) = %v, want %v", tt.role, tt.attr, got, tt.allow)
+		}
+	}
+
+	if got, want := RoleCaseScrollbar.Requires(), []string{"aria-controls", "aria-valuenow"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Requires = %v, want %v", got, want)
+	}
+	if got := RoleCase("widget").Requires(); len(got) != 0 {
+		t.Errorf("Requires of an unknown role = %v, want none", got)
+	}
+
+	// Requires returns a copy
+	RoleCaseScrollbar.Requires()[0] = "aria-hidden"
+	if RoleCaseScrollbar.Requires()[0] != "aria-controls" {
+		t.Error("Requires returns the table of the role")
+	}
+}
@@ -0,0 +1,375 @@
+package aria
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// RoleCase is a concrete ARIA 1.2 role, abstract roles can't be set
+type RoleCase string
+
+const (
+	RoleCaseAlert            RoleCase = "alert"
+	RoleCaseAlertDialog      RoleCase = "alertdialog"
+	RoleCaseApplication      RoleCase = "application"
+	RoleCaseArticle          RoleCase = "article"
+	RoleCaseBanner           RoleCase = "banner"
+	RoleCaseBlockquote       RoleCase = "blockquote"
+	RoleCaseButton           RoleCase = "button"
+	RoleCaseCaption          RoleCase = "caption"
+	RoleCaseCell             RoleCase = "cell"
+	RoleCaseCheckbox         RoleCase = "checkbox"
+	RoleCaseCode             RoleCase = "code"
+	RoleCaseColumnHeader     RoleCase = "columnheader"
+	RoleCaseCombobox         RoleCase = "combobox"
+	RoleCaseComplementary    RoleCase = "complementary"
+	RoleCaseContentInfo      RoleCase = "contentinfo"
+	RoleCaseDefinition       RoleCase = "definition"
+	RoleCaseDeletion         RoleCase = "deletion"
+	RoleCaseDialog           RoleCase = "dialog"
+	RoleCaseDocument         RoleCase = "document"
+	RoleCaseEmphasis         RoleCase = "emphasis"
+	RoleCaseFeed             RoleCase = "feed"
+	RoleCaseFigure           RoleCase = "figure"
+	RoleCaseForm             RoleCase = "form"
+	RoleCaseGeneric          RoleCase = "generic"
+	RoleCaseGrid             RoleCase = "grid"
+	RoleCaseGridCell         RoleCase = "gridcell"
+	RoleCaseGroup            RoleCase = "group"
+	RoleCaseHeading          RoleCase = "heading"
+	RoleCaseImg              RoleCase = "img"
+	RoleCaseInsertion        RoleCase = "insertion"
+	RoleCaseLink             RoleCase = "link"
+	RoleCaseList             RoleCase = "list"
+	RoleCaseListbox          RoleCase = "listbox"
+	RoleCaseListItem         RoleCase = "listitem"
+	RoleCaseLog              RoleCase = "log"
+	RoleCaseMain             RoleCase = "main"
+	RoleCaseMarquee          RoleCase = "marquee"
+	RoleCaseMath             RoleCase = "math"
+	RoleCaseMenu             RoleCase = "menu"
+	RoleCaseMenubar          RoleCase = "menubar"
+	RoleCaseMenuItem         RoleCase = "menuitem"
+	RoleCaseMenuItemCheckbox RoleCase = "menuitemcheckbox"
+	RoleCaseMenuItemRadio    RoleCase = "menuitemradio"
+	RoleCaseMeter            RoleCase = "meter"
+	RoleCaseNavigation       RoleCase = "navigation"
+	RoleCaseNone             RoleCase = "none"
+	RoleCaseNote             RoleCase = "note"
+	RoleCaseOption           RoleCase = "option"
+	RoleCaseParagraph        RoleCase = "paragraph"
+	RoleCasePresentation     RoleCase = "presentation"
+	RoleCaseProgressbar      RoleCase = "progressbar"
+	RoleCaseRadio            RoleCase = "radio"
+	RoleCaseRadioGroup       RoleCase = "radiogroup"
+	RoleCaseRegion           RoleCase = "region"
+	RoleCaseRow              RoleCase = "row"
+	RoleCaseRowGroup         RoleCase = "rowgroup"
+	RoleCaseRowHeader        RoleCase = "rowheader"
+	RoleCaseScrollbar        RoleCase = "scrollbar"
+	RoleCaseSearch           RoleCase = "search"
+	RoleCaseSearchbox        RoleCase = "searchbox"
+	RoleCaseSeparator        RoleCase = "separator"
+	RoleCaseSlider           RoleCase = "slider"
+	RoleCaseSpinbutton       RoleCase = "spinbutton"
+	RoleCaseStatus           RoleCase = "status"
+	RoleCaseStrong           RoleCase = "strong"
+	RoleCaseSubscript        RoleCase = "subscript"
+	RoleCaseSuperscript      RoleCase = "superscript"
+	RoleCaseSwitch           RoleCase = "switch"
+	RoleCaseTab              RoleCase = "tab"
+	RoleCaseTable            RoleCase = "table"
+	RoleCaseTablist          RoleCase = "tablist"
+	RoleCaseTabpanel         RoleCase = "tabpanel"
+	RoleCaseTerm             RoleCase = "term"
+	RoleCaseTextbox          RoleCase = "textbox"
+	RoleCaseTime             RoleCase = "time"
+	RoleCaseTimer            RoleCase = "timer"
+	RoleCaseToolbar          RoleCase = "toolbar"
+	RoleCaseTooltip          RoleCase = "tooltip"
+	RoleCaseTree             RoleCase = "tree"
+	RoleCaseTreegrid         RoleCase = "treegrid"
+	RoleCaseTreeItem         RoleCase = "treeitem"
+)
+
+// RoleCaseDirectory is a list of references to members of a group
+//
+// Deprecated: the directory role is deprecated in ARIA 1.2, use list
+const RoleCaseDirectory RoleCase = "directory"
+
+// globalAttrs are allowed on every role
+var globalAttrs = []string{
+	"aria-atomic",
+	"aria-busy",
+	"aria-controls",
+	"aria-current",
+	"aria-describedby",
+	"aria-details",
+	"aria-disabled",
+	"aria-dropeffect",
+	"aria-errormessage",
+	"aria-flowto",
+	"aria-grabbed",
+	"aria-haspopup",
+	"aria-hidden",
+	"aria-invalid",
+	"aria-keyshortcuts",
+	"aria-label",
+	"aria-labelledby",
+	"aria-live",
+	"aria-owns",
+	"aria-relevant",
+	"aria-roledescription",
+}
+
+// roleAttrs are the states and properties of a role besides the global ones, inherited ones included
+type roleAttrs struct {
+	required  []string
+	supported []string
+	// nameProhibited roles can't be named with aria-label and aria-labelledby
+	nameProhibited bool
+}
+
+var (
+	cellAttrs      = []string{"aria-colindex", "aria-colspan", "aria-rowindex", "aria-rowspan"}
+	gridCellAttrs  = append([]string{"aria-expanded", "aria-readonly", "aria-required", "aria-selected"}, cellAttrs...)
+	headerAttrs    = append([]string{"aria-sort"}, gridCellAttrs...)
+	rangeAttrs     = []string{"aria-valuemax", "aria-valuemin", "aria-valuenow", "aria-valuetext"}
+	setAttrs       = []string{"aria-posinset", "aria-setsize"}
+	textboxAttrs   = []string{"aria-activedescendant", "aria-autocomplete", "aria-multiline", "aria-placeholder", "aria-readonly", "aria-required"}
+	gridAttrs      = []string{"aria-activedescendant", "aria-colcount", "aria-multiselectable", "aria-readonly", "aria-rowcount"}
+	treeAttrs      = []string{"aria-activedescendant", "aria-multiselectable", "aria-orientation", "aria-required"}
+	menuItemAttrs  = append([]string{"aria-expanded"}, setAttrs...)
+	nameProhibited = roleAttrs{nameProhibited: true}
+)
+
+// roles is the role table of ARIA 1.2
+var roles = map[RoleCase]roleAttrs{
+	RoleCaseAlert:            {},
+	RoleCaseAlertDialog:      {supported: []string{"aria-modal"}},
+	RoleCaseApplication:      {supported: []string{"aria-activedescendant", "aria-expanded"}},
+	RoleCaseArticle:          {supported: setAttrs},
+	RoleCaseBanner:           {},
+	RoleCaseBlockquote:       {},
+	RoleCaseButton:           {supported: []string{"aria-expanded", "aria-pressed"}},
+	RoleCaseCaption:          nameProhibited,
+	RoleCaseCell:             {supported: cellAttrs},
+	RoleCaseCheckbox:         {required: []string{"aria-checked"}, supported: []string{"aria-expanded", "aria-readonly", "aria-required"}},
+	RoleCaseCode:             nameProhibited,
+	RoleCaseColumnHeader:     {supported: headerAttrs},
+	RoleCaseCombobox:         {required: []string{"aria-controls", "aria-expanded"}, supported: []string{"aria-activedescendant", "aria-autocomplete", "aria-readonly", "aria-required"}},
+	RoleCaseComplementary:    {},
+	RoleCaseContentInfo:      {},
+	RoleCaseDefinition:       {},
+	RoleCaseDeletion:         nameProhibited,
+	RoleCaseDialog:           {supported: []string{"aria-modal"}},
+	RoleCaseDirectory:        {},
+	RoleCaseDocument:         {},
+	RoleCaseEmphasis:         nameProhibited,
+	RoleCaseFeed:             {},
+	RoleCaseFigure:           {},
+	RoleCaseForm:             {},
+	RoleCaseGeneric:          nameProhibited,
+	RoleCaseGrid:             {supported: gridAttrs},
+	RoleCaseGridCell:         {supported: gridCellAttrs},
+	RoleCaseGroup:            {supported: []string{"aria-activedescendant"}},
+	RoleCaseHeading:          {required: []string{"aria-level"}},
+	RoleCaseImg:              {},
+	RoleCaseInsertion:        nameProhibited,
+	RoleCaseLink:             {supported: []string{"aria-expanded"}},
+	RoleCaseList:             {},
+	RoleCaseListbox:          {supported: []string{"aria-activedescendant", "aria-expanded", "aria-multiselectable", "aria-orientation", "aria-readonly", "aria-required"}},
+	RoleCaseListItem:         {supported: append([]string{"aria-level"}, setAttrs...)},
+	RoleCaseLog:              {},
+	RoleCaseMain:             {},
+	RoleCaseMarquee:          {},
+	RoleCaseMath:             {},
+	RoleCaseMenu:             {supported: []string{"aria-activedescendant", "aria-orientation"}},
+	RoleCaseMenubar:          {supported: []string{"aria-activedescendant", "aria-orientation"}},
+	RoleCaseMenuItem:         {supported: menuItemAttrs},
+	RoleCaseMenuItemCheckbox: {required: []string{"aria-checked"}, supported: menuItemAttrs},
+	RoleCaseMenuItemRadio:    {required: []string{"aria-checked"}, supported: menuItemAttrs},
+	RoleCaseMeter:            {required: []string{"aria-valuenow"}, supported: rangeAttrs},
+	RoleCaseNavigation:       {},
+	RoleCaseNone:             nameProhibited,
+	RoleCaseNote:             {},
+	RoleCaseOption:           {required: []string{"aria-selected"}, supported: append([]string{"aria-checked"}, setAttrs...)},
+	RoleCaseParagraph:        nameProhibited,
+	RoleCasePresentation:     nameProhibited,
+	RoleCaseProgressbar:      {supported: rangeAttrs},
+	RoleCaseRadio:            {required: []string{"aria-checked"}, supported: setAttrs},
+	RoleCaseRadioGroup:       {supported: []string{"aria-activedescendant", "aria-expanded", "aria-orientation", "aria-readonly", "aria-required"}},
+	RoleCaseRegion:           {},
+	RoleCaseRow:              {supported: []string{"aria-activedescendant", "aria-colindex", "aria-expanded", "aria-level", "aria-posinset", "aria-rowindex", "aria-selected", "aria-setsize"}},
+	RoleCaseRowGroup:         {},
+	RoleCaseRowHeader:        {supported: headerAttrs},
+	RoleCaseScrollbar:        {required: []string{"aria-controls", "aria-valuenow"}, supported: append([]string{"aria-orientation"}, rangeAttrs...)},
+	RoleCaseSearch:           {},
+	RoleCaseSearchbox:        {supported: textboxAttrs},
+	RoleCaseSeparator:        {supported: append([]string{"aria-orientation"}, rangeAttrs...)},
+	RoleCaseSlider:           {required: []string{"aria-valuenow"}, supported: append([]string{"aria-orientation", "aria-readonly"}, rangeAttrs...)},
+	RoleCaseSpinbutton:       {supported: append([]string{"aria-activedescendant", "aria-readonly", "aria-required"}, rangeAttrs...)},
+	RoleCaseStatus:           {},
+	RoleCaseStrong:           nameProhibited,
+	RoleCaseSubscript:        nameProhibited,
+	RoleCaseSuperscript:      nameProhibited,
+	RoleCaseSwitch:           {required: []string{"aria-checked"}, supported: []string{"aria-expanded", "aria-readonly", "aria-required"}},
+	RoleCaseTab:              {supported: append([]string{"aria-expanded", "aria-selected"}, setAttrs...)},
+	RoleCaseTable:            {supported: []string{"aria-colcount", "aria-rowcount"}},
+	RoleCaseTablist:          {supported: []string{"aria-activedescendant", "aria-multiselectable", "aria-orientation"}},
+	RoleCaseTabpanel:         {},
+	RoleCaseTerm:             {},
+	RoleCaseTextbox:          {supported: textboxAttrs},
+	RoleCaseTime:             {},
+	RoleCaseTimer:            {},
+	RoleCaseToolbar:          {supported: []string{"aria-activedescendant", "aria-orientation"}},
+	RoleCaseTooltip:          {},
+	RoleCaseTree:             {supported: treeAttrs},
+	RoleCaseTreegrid:         {supported: append(append([]string{}, gridAttrs...), "aria-orientation", "aria-required")},
+	RoleCaseTreeItem:         {supported: []string{"aria-checked", "aria-expanded", "aria-level", "aria-posinset", "aria-selected", "aria-setsize"}},
+}
+
+// Valid reports whether the role is a concrete ARIA 1.2 role
+func (c RoleCase) Valid() bool {
+	_, ok := roles[c]
+
+	return ok
+}
+
+func (c RoleCase) String() string {
+	return string(c)
+}
+
+func (c RoleCase) check() error {
+	if c.Valid() {
+		return nil
+	}
+
+	return &RoleError{Role: c, Reason: "not an ARIA 1.2 role"}
+}
+
+// RoleCaseValues returns the concrete ARIA 1.2 roles sorted by name
+func RoleCaseValues() []RoleCase {
+	values := make([]RoleCase, 0, len(roles))
+	for c := range roles {
+		values = append(values, c)
+	}
+	sort.Slice(values, func(i, j int) bool {
+		return values[i] < values[j]
+	})
+
+	return values
+}
+
+// ParseRoleCase returns the role that matches the value ignoring case
+func ParseRoleCase(value string) (RoleCase, error) {
+	if c := RoleCase(strings.ToLower(value)); c.Valid() {
+		return c, nil
+	}
+
+	return "", &RoleError{Role: RoleCase(value), Reason: "not an ARIA 1.2 role"}
+}
+
+// Allows reports whether the state or property can be set on an element with the role
+func (c RoleCase) Allows(attr string) bool {
+	spec, ok := roles[c]
+	if !ok {
+		return false
+	}
+
+	if spec.nameProhibited && (attr == "aria-label" || attr == "aria-labelledby") {
+		return false
+	}
+
+	return contains(globalAttrs, attr) || contains(spec.required, attr) || contains(spec.supported, attr)
+}
+
+// Requires returns the states and properties an element with the role must have.
+// Native semantics can provide them, <input type="checkbox"> has no need for aria-checked
+func (c RoleCase) Requires() []string {
+	return append([]string(nil), roles[c].required...)
+}
+
+// Check reports the states and properties that the role doesn't allow and the required ones that are missing
+func (c RoleCase) Check(attrs ...string) error {
+	if err := c.check(); err != nil {
+		return err
+	}
+
+	err := &RoleError{Role: c}
+	for _, attr := range attrs {
+		if !c.Allows(attr) {
+			err.Disallowed = append(err.Disallowed, attr)
+		}
+	}
+	for _, attr := range roles[c].required {
+		if !contains(attrs, attr) {
+			err.Missing = append(err.Missing, attr)
+		}
+	}
+
+	if err.Disallowed == nil && err.Missing == nil {
+		return nil
+	}
+
+	return err
+}
+
+// Attributes returns every ARIA 1.2 state and property sorted by name
+func Attributes() []string {
+	seen := map[string]bool{}
+	for _, attr := range globalAttrs {
+		seen[attr] = true
+	}
+	for _, spec := range roles {
+		for _, attr := range spec.required {
+			seen[attr] = true
+		}
+		for _, attr := range spec.supported {
+			seen[attr] = true
+		}
+	}
+
+	attrs := make([]string, 0, len(seen))
+	for attr := range seen {
+		attrs = append(attrs, attr)
+	}
+	sort.Strings(attrs)
+
+	return attrs
+}
+
+// RoleError reports the states and properties that don't fit the role
+type RoleError struct {
+	Role       RoleCase
+	Reason     string
+	Disallowed []string
+	Missing    []string
+}
+
+func (e *RoleError) Error() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("role %q: %s", e.Role, e.Reason)
+	}
+
+	var problems []string
+	if e.Disallowed != nil {
+		problems = append(problems, "does not allow "+strings.Join(e.Disallowed, ", "))
+	}
+	if e.Missing != nil {
+		problems = append(problems, "requires "+strings.Join(e.Missing, ", "))
+	}
+
+	return fmt.Sprintf("role %q %s", e.Role, strings.Join(problems, " and "))
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
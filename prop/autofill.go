@@ -0,0 +1,215 @@
+package prop
+
+import (
+	"fmt"
+	"strings"
+)
+
+// autofillGroup is the control group of a field name, it lists the input types the field name is allowed on.
+// Every group is also allowed on <textarea> and <select>
+type autofillGroup struct {
+	name    string
+	types   []InputTypeCase
+	contact bool
+}
+
+var (
+	autofillText      = &autofillGroup{name: "text", types: []InputTypeCase{InputTypeCaseHidden, InputTypeCaseText, InputTypeCaseSearch}}
+	autofillMultiline = &autofillGroup{name: "multiline", types: []InputTypeCase{InputTypeCaseHidden}}
+	autofillPassword  = &autofillGroup{name: "password", types: []InputTypeCase{InputTypeCaseHidden, InputTypeCaseText, InputTypeCaseSearch, InputTypeCasePassword}}
+	// usernames are often email addresses
+	autofillUsername = &autofillGroup{name: "username", types: []InputTypeCase{InputTypeCaseHidden, InputTypeCaseText, InputTypeCaseSearch, InputTypeCaseEmail}}
+	autofillURL      = &autofillGroup{name: "url", types: []InputTypeCase{InputTypeCaseHidden, InputTypeCaseText, InputTypeCaseSearch, InputTypeCaseURL}}
+	autofillNumeric  = &autofillGroup{name: "numeric", types: []InputTypeCase{InputTypeCaseHidden, InputTypeCaseText, InputTypeCaseSearch, InputTypeCaseNumber}}
+	autofillMonth    = &autofillGroup{name: "month", types: []InputTypeCase{InputTypeCaseHidden, InputTypeCaseText, InputTypeCaseSearch, InputTypeCaseMonth}}
+	autofillDate     = &autofillGroup{name: "date", types: []InputTypeCase{InputTypeCaseHidden, InputTypeCaseText, InputTypeCaseSearch, InputTypeCaseDate}}
+
+	autofillContactText  = &autofillGroup{name: "text", types: autofillText.types, contact: true}
+	autofillContactTel   = &autofillGroup{name: "tel", types: []InputTypeCase{InputTypeCaseHidden, InputTypeCaseText, InputTypeCaseSearch, InputTypeCaseTel}, contact: true}
+	autofillContactEmail = &autofillGroup{name: "e-mail", types: []InputTypeCase{InputTypeCaseHidden, InputTypeCaseText, InputTypeCaseSearch, InputTypeCaseEmail}, contact: true}
+	autofillContactURL   = &autofillGroup{name: "url", types: autofillURL.types, contact: true}
+)
+
+// autofillGroups is the control group table of the autofill field names
+var autofillGroups = map[AutofillFieldCase]*autofillGroup{
+	AutofillFieldCaseName:                autofillText,
+	AutofillFieldCaseHonorificPrefix:     autofillText,
+	AutofillFieldCaseGivenName:           autofillText,
+	AutofillFieldCaseAdditionalName:      autofillText,
+	AutofillFieldCaseFamilyName:          autofillText,
+	AutofillFieldCaseHonorificSuffix:     autofillText,
+	AutofillFieldCaseNickname:            autofillText,
+	AutofillFieldCaseUsername:            autofillUsername,
+	AutofillFieldCaseNewPassword:         autofillPassword,
+	AutofillFieldCaseCurrentPassword:     autofillPassword,
+	AutofillFieldCaseOneTimeCode:         autofillPassword,
+	AutofillFieldCaseOrganizationTitle:   autofillText,
+	AutofillFieldCaseOrganization:        autofillText,
+	AutofillFieldCaseStreetAddress:       autofillMultiline,
+	AutofillFieldCaseAddressLine1:        autofillText,
+	AutofillFieldCaseAddressLine2:        autofillText,
+	AutofillFieldCaseAddressLine3:        autofillText,
+	AutofillFieldCaseAddressLevel4:       autofillText,
+	AutofillFieldCaseAddressLevel3:       autofillText,
+	AutofillFieldCaseAddressLevel2:       autofillText,
+	AutofillFieldCaseAddressLevel1:       autofillText,
+	AutofillFieldCaseCountry:             autofillText,
+	AutofillFieldCaseCountryName:         autofillText,
+	AutofillFieldCasePostalCode:          autofillText,
+	AutofillFieldCaseCCName:              autofillText,
+	AutofillFieldCaseCCGivenName:         autofillText,
+	AutofillFieldCaseCCAdditionalName:    autofillText,
+	AutofillFieldCaseCCFamilyName:        autofillText,
+	AutofillFieldCaseCCNumber:            autofillText,
+	AutofillFieldCaseCCExp:               autofillMonth,
+	AutofillFieldCaseCCExpMonth:          autofillNumeric,
+	AutofillFieldCaseCCExpYear:           autofillNumeric,
+	AutofillFieldCaseCCCSC:               autofillPassword,
+	AutofillFieldCaseCCType:              autofillText,
+	AutofillFieldCaseTransactionCurrency: autofillText,
+	AutofillFieldCaseTransactionAmount:   autofillNumeric,
+	AutofillFieldCaseLanguage:            autofillText,
+	AutofillFieldCaseBday:                autofillDate,
+	AutofillFieldCaseBdayDay:             autofillNumeric,
+	AutofillFieldCaseBdayMonth:           autofillNumeric,
+	AutofillFieldCaseBdayYear:            autofillNumeric,
+	AutofillFieldCaseSex:                 autofillText,
+	AutofillFieldCaseURL:                 autofillURL,
+	AutofillFieldCasePhoto:               autofillURL,
+	AutofillFieldCaseTel:                 autofillContactTel,
+	AutofillFieldCaseTelCountryCode:      autofillContactText,
+	AutofillFieldCaseTelNational:         autofillContactText,
+	AutofillFieldCaseTelAreaCode:         autofillContactText,
+	AutofillFieldCaseTelLocal:            autofillContactText,
+	AutofillFieldCaseTelLocalPrefix:      autofillContactText,
+	AutofillFieldCaseTelLocalSuffix:      autofillContactText,
+	AutofillFieldCaseTelExtension:        autofillContactText,
+	AutofillFieldCaseEmail:               autofillContactEmail,
+	AutofillFieldCaseIMPP:                autofillContactURL,
+}
+
+// AutofillTokens is the detailed autocomplete attribute: [section-*] [shipping|billing] [contact] field [webauthn]
+// ex: NewAutofillTokens(AutofillFieldCaseTel).Section("blue").Address(AutofillAddressCaseShipping).Contact(AutofillContactCaseMobile)
+// => section-blue shipping mobile tel
+type AutofillTokens struct {
+	section  string
+	address  AutofillAddressCase
+	contact  AutofillContactCase
+	field    AutofillFieldCase
+	webauthn bool
+}
+
+// Section groups the fields of one form that describe the same person or address, the section- prefix is added
+func (b *AutofillTokens) Section(name string) *AutofillTokens {
+	b.section = name
+
+	return b
+}
+
+func (b *AutofillTokens) Address(c AutofillAddressCase) *AutofillTokens {
+	b.address = c
+
+	return b
+}
+
+// Contact applies only to the tel, email and impp field names
+func (b *AutofillTokens) Contact(c AutofillContactCase) *AutofillTokens {
+	b.contact = c
+
+	return b
+}
+
+// WebAuthn lets the browser offer passkeys in the field
+func (b *AutofillTokens) WebAuthn() *AutofillTokens {
+	b.webauthn = true
+
+	return b
+}
+
+func (b *AutofillTokens) Validate() error {
+	_, err := b.buildAutofill()
+
+	return err
+}
+
+// ValidateFor reports whether the field name is allowed on the element, the input type is ignored for
+// <textarea> and <select>
+func (b *AutofillTokens) ValidateFor(element string, t InputTypeCase) error {
+	tokens, err := b.buildAutofill()
+	if err != nil {
+		return err
+	}
+
+	element = strings.ToLower(element)
+	switch element {
+	case "textarea", "select":
+		return nil
+	case "input":
+	default:
+		return &ValueError{Attr: "autocomplete", Value: tokens, Reason: "autofill field names apply to <input>, <select> and <textarea>, not <" + element + ">"}
+	}
+
+	if t == "" {
+		t = InputTypeCaseText
+	}
+
+	group := autofillGroups[b.field]
+	for _, allowed := range group.types {
+		if allowed == t {
+			return nil
+		}
+	}
+
+	return &ValueError{Attr: "autocomplete", Value: tokens, Reason: fmt.Sprintf("%s is in the %s control group, it is not allowed on type=%s", b.field, group.name, t)}
+}
+
+func (b *AutofillTokens) buildAutofill() (string, error) {
+	var tokens []string
+	bad := func(reason string) error {
+		return &ValueError{Attr: "autocomplete", Value: strings.Join(append(tokens, string(b.field)), " "), Reason: reason}
+	}
+
+	if b.section != "" {
+		tokens = append(tokens, "section-"+b.section)
+		if strings.ContainsAny(b.section, " \t\n\f\r") {
+			return "", bad("the section name must not contain whitespace")
+		}
+	}
+
+	if b.address != "" {
+		if !b.address.Valid() {
+			return "", bad("unknown address token " + b.address.String())
+		}
+
+		tokens = append(tokens, b.address.String())
+	}
+
+	if b.contact != "" {
+		if !b.contact.Valid() {
+			return "", bad("unknown contact token " + b.contact.String())
+		}
+
+		tokens = append(tokens, b.contact.String())
+	}
+
+	group, ok := autofillGroups[b.field]
+	if !ok {
+		return "", bad("unknown field name " + b.field.String())
+	}
+	if b.contact != "" && !group.contact {
+		return "", bad(b.field.String() + " can't follow a contact token")
+	}
+	tokens = append(tokens, b.field.String())
+
+	if b.webauthn {
+		tokens = append(tokens, "webauthn")
+	}
+
+	return strings.Join(tokens, " "), nil
+}
+
+func NewAutofillTokens(field AutofillFieldCase) *AutofillTokens {
+	return &AutofillTokens{
+		field: field,
+	}
+}
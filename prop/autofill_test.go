@@ -0,0 +1,32 @@
+package prop
+
+import "testing"
+
+func TestAutofillValidateFor(t *testing.T) {
+	tests := []struct {
+		name    string
+		tokens  *AutofillTokens
+		element string
+		typ     InputTypeCase
+		err     bool
+	}{
+		{name: "cc-csc on a password input", tokens: NewAutofillTokens(AutofillFieldCaseCCCSC), element: "input", typ: InputTypeCasePassword},
+		{name: "cc-csc on a text input", tokens: NewAutofillTokens(AutofillFieldCaseCCCSC), element: "input"},
+		{name: "cc-csc on a number input", tokens: NewAutofillTokens(AutofillFieldCaseCCCSC), element: "input", typ: InputTypeCaseNumber, err: true},
+		{name: "cc-number on a password input", tokens: NewAutofillTokens(AutofillFieldCaseCCNumber), element: "input", typ: InputTypeCasePassword, err: true},
+		{name: "current-password", tokens: NewAutofillTokens(AutofillFieldCaseCurrentPassword).WebAuthn(), element: "input", typ: InputTypeCasePassword},
+		{name: "email on an email input", tokens: NewAutofillTokens(AutofillFieldCaseEmail).Contact(AutofillContactCaseWork), element: "input", typ: InputTypeCaseEmail},
+		{name: "any type on a textarea", tokens: NewAutofillTokens(AutofillFieldCaseStreetAddress), element: "textarea", typ: InputTypeCaseNumber},
+		{name: "not a form control", tokens: NewAutofillTokens(AutofillFieldCaseName), element: "div", err: true},
+		{name: "contact before a field without contact", tokens: NewAutofillTokens(AutofillFieldCaseName).Contact(AutofillContactCaseHome), element: "input", err: true},
+		{name: "unknown field name", tokens: NewAutofillTokens("nope"), element: "input", err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.tokens.ValidateFor(tt.element, tt.typ); (err != nil) != tt.err {
+				t.Errorf("ValidateFor error = %v, want error %v", err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package prop_test
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/Hand-of-Doom/Vecty-Props/prop"
+	"github.com/hexops/vecty"
+)
+
+type testCase struct {
+	helper  string
+	element string
+	applyer vecty.Applyer
+	// absent cases render no attribute at all
+	absent bool
+}
+
+func must(applyer vecty.Applyer, err error) vecty.Applyer {
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// cases are rendered and checked against the dataset by TestHelpers, every exported helper needs one
+var cases = []testCase{
+	{helper: "Accept", element: "input", applyer: prop.Accept(prop.AcceptCaseImage)},
+	{helper: "AcceptE", element: "input", applyer: must(prop.AcceptE(prop.AcceptCaseImage))},
+	{helper: "AcceptCharset", element: "form", applyer: prop.AcceptCharset("utf-8", "iso-8859-1")},
+	{helper: "AccessKey", element: "button", applyer: prop.AccessKey("s")},
+	{helper: "Action", element: "form", applyer: prop.Action("/api/endpoint")},
+	{helper: "ActionE", element: "form", applyer: must(prop.ActionE("/api/endpoint"))},
+	{helper: "Alt", element: "img", applyer: prop.Alt("a cat")},
+	{helper: "Async", element: "script", applyer: prop.Async(true)},
+	{helper: "Async", element: "script", applyer: prop.Async(false), absent: true},
+	{helper: "Autocomplete", element: "input", applyer: prop.Autocomplete(false)},
+	{helper: "Autofill", element: "input", applyer: prop.Autofill(prop.NewAutofillTokens(prop.AutofillFieldCaseTel).
+		Section("blue").Address(prop.AutofillAddressCaseShipping).Contact(prop.AutofillContactCaseMobile))},
+	{helper: "AutofillE", element: "input", applyer: must(prop.AutofillE(
+		prop.NewAutofillTokens(prop.AutofillFieldCaseUsername).WebAuthn()))},
+	{helper: "Autofocus", element: "input", applyer: prop.Autofocus(true)},
+	{helper: "Autoplay", element: "video", applyer: prop.Autoplay(true)},
+	{helper: "ButtonType", element: "button", applyer: prop.ButtonType(prop.ButtonTypeCaseReset)},
+	{helper: "ButtonTypeE", element: "button", applyer: must(prop.ButtonTypeE(prop.ButtonTypeCaseReset))},
+	{helper: "Charset", element: "meta", applyer: prop.Charset("utf-8")},
+	{helper: "Checked", element: "input", applyer: prop.Checked(true)},
+	{helper: "Cite", element: "blockquote", applyer: prop.Cite("https://example.com/quote")},
+	{helper: "CiteE", element: "blockquote", applyer: must(prop.CiteE("https://example.com/quote"))},
+	{helper: "Cols", element: "textarea", applyer: prop.Cols(40)},
+	{helper: "Colspan", element: "td", applyer: prop.Colspan(2)},
+	{helper: "Content", element: "meta", applyer: prop.Content("width=device-width")},
+	{helper: "ContentEditable", element: "div", applyer: prop.ContentEditable(true)},
+	{helper: "ContentEditable", element: "div", applyer: prop.ContentEditable(false)},
+	{helper: "Controls", element: "audio", applyer: prop.Controls(true)},
+	{helper: "Coords", element: "area", applyer: prop.Coords(prop.NewRectCoords(0, 0, 10, 10))},
+	{helper: "Coords", element: "area", applyer: prop.Coords(prop.NewCircleCoords(5, 5, "10%"))},
+	{helper: "CoordsE", element: "area", applyer: must(prop.CoordsE(prop.NewPolyCoords(
+		prop.NewPolyCoord(0, 0), prop.NewPolyCoord(10, 0), prop.NewPolyCoord(5, 5))))},
+	{helper: "Data", element: "object", applyer: prop.Data("/movie.swf")},
+	{helper: "DataE", element: "object", applyer: must(prop.DataE("/movie.swf"))},
+	{helper: "Datetime", element: "time", applyer: prop.Datetime(prop.NewGlobalDatetime(time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)))},
+	{helper: "Datetime", element: "del", applyer: prop.Datetime(prop.NewGlobalDatetime(time.Date(2024, 1, 2, 15, 4, 5, 120e6, time.FixedZone("", -3*60*60))))},
+	{helper: "Datetime", element: "time", applyer: prop.Datetime(prop.NewDate(2024, time.February, 29))},
+	{helper: "Datetime", element: "time", applyer: prop.Datetime(prop.NewMonth(2024, time.March))},
+	{helper: "Datetime", element: "time", applyer: prop.Datetime(prop.NewWeek(2020, 53))},
+	{helper: "Datetime", element: "time", applyer: prop.Datetime(prop.NewTimeOfDay(9, 30, 0))},
+	{helper: "Datetime", element: "time", applyer: prop.Datetime(prop.NewTimeOfDay(9, 30, 5).Millisecond(250))},
+	{helper: "Datetime", element: "time", applyer: prop.Datetime(prop.LocalDatetimeOf(time.Date(2024, 1, 2, 15, 4, 0, 0, time.UTC)))},
+	{helper: "Datetime", element: "time", applyer: prop.Datetime(prop.NewYearlessDate(time.December, 25))},
+	{helper: "Datetime", element: "time", applyer: prop.Datetime(prop.NewDuration(2*time.Hour + 30*time.Minute))},
+	{helper: "Datetime", element: "time", applyer: prop.Datetime(prop.NewDuration(36*time.Hour + 1500*time.Millisecond))},
+	{helper: "Datetime", element: "time", applyer: prop.Datetime(prop.NewDuration(0))},
+	{helper: "DatetimeE", element: "ins", applyer: must(prop.DatetimeE(prop.NewDate(2024, time.March, 1)))},
+	{helper: "Default", element: "track", applyer: prop.Default(true)},
+	{helper: "Defer", element: "script", applyer: prop.Defer(true)},
+	{helper: "Dir", element: "p", applyer: prop.Dir(prop.DirCaseRTL)},
+	{helper: "DirE", element: "p", applyer: must(prop.DirE(prop.DirCaseRTL))},
+	{helper: "Dirname", element: "textarea", applyer: prop.Dirname("comment")},
+	{helper: "Disabled", element: "button", applyer: prop.Disabled(true)},
+	{helper: "Download", element: "a", applyer: prop.Download(true)},
+	{helper: "Download", element: "a", applyer: prop.Download(false), absent: true},
+	{helper: "DownloadWithFilename", element: "a", applyer: prop.DownloadWithFilename("report.pdf")},
+	{helper: "Draggable", element: "div", applyer: prop.Draggable(false)},
+	{helper: "Enctype", element: "form", applyer: prop.Enctype(prop.EnctypeCaseMultipartFormData)},
+	{helper: "EnctypeE", element: "form", applyer: must(prop.EnctypeE(prop.EnctypeCaseMultipartFormData))},
+	{helper: "ExternalLink", element: "a", applyer: prop.ExternalLink("https://example.com/a b?q=1", nil)},
+	{helper: "ExternalLink", element: "area", applyer: prop.ExternalLink("https://example.com", prop.NewExternalLinkOptions().
+		Target(prop.TargetCaseSelf).Sponsored())},
+	{helper: "ExternalLinkE", element: "a", applyer: must(prop.ExternalLinkE("mailto:team@example.com", prop.NewExternalLinkOptions().
+		ReferrerPolicy(prop.ReferrerPolicyCaseStrictOrigin).UGC().Nofollow()))},
+	{helper: "For", element: "label", applyer: prop.For(prop.NewEntityRef("email"))},
+	{helper: "Form", element: "input", applyer: prop.Form(prop.NewEntityRef("signup"))},
+	{helper: "FormAction", element: "button", applyer: prop.FormAction("/submit")},
+	{helper: "FormActionE", element: "button", applyer: must(prop.FormActionE("/submit"))},
+	{helper: "Headers", element: "td", applyer: prop.Headers(prop.NewEntityRef("h1"), prop.NewEntityRef("h2"))},
+	{helper: "Height", element: "img", applyer: prop.Height(100)},
+	{helper: "Hidden", element: "div", applyer: prop.Hidden(true)},
+	{helper: "Hidden", element: "div", applyer: prop.Hidden(false), absent: true},
+	{helper: "High", element: "meter", applyer: prop.High(80)},
+	{helper: "Href", element: "a", applyer: prop.Href("/")},
+	{helper: "HrefE", element: "a", applyer: must(prop.HrefE("/"))},
+	{helper: "Href", element: "a", applyer: prop.Href(prop.NewURLBuilder("https://example.com/api/").
+		Path("users", "John Doe").Query("tab", "posts").URL())},
+	{helper: "HrefLang", element: "link", applyer: prop.HrefLang("en-US")},
+	{helper: "HttpEquiv", element: "meta", applyer: prop.HttpEquiv(prop.HttpEquivCaseRefresh)},
+	{helper: "HttpEquivE", element: "meta", applyer: must(prop.HttpEquivE(prop.HttpEquivCaseRefresh))},
+	{helper: "ID", element: "div", applyer: prop.ID(prop.NewEntityRef("main"))},
+	{helper: "InputType", element: "input", applyer: prop.InputType(prop.InputTypeCaseEmail)},
+	{helper: "InputTypeE", element: "input", applyer: must(prop.InputTypeE(prop.InputTypeCaseEmail))},
+	{helper: "IsMap", element: "img", applyer: prop.IsMap(true)},
+	{helper: "Kind", element: "track", applyer: prop.Kind(prop.KindCaseSubtitles)},
+	{helper: "KindE", element: "track", applyer: must(prop.KindE(prop.KindCaseSubtitles))},
+	{helper: "Label", element: "track", applyer: prop.Label("English")},
+	{helper: "Lang", element: "p", applyer: prop.Lang("en")},
+	{helper: "List", element: "input", applyer: prop.List(prop.NewEntityRef("browsers"))},
+	{helper: "Loop", element: "audio", applyer: prop.Loop(true)},
+	{helper: "Low", element: "meter", applyer: prop.Low(20)},
+	{helper: "Max", element: "input", applyer: prop.Max(prop.Text("10"))},
+	{helper: "Max", element: "meter", applyer: prop.Max(prop.Number(0.5))},
+	{helper: "Max", element: "input", applyer: prop.Max(prop.NewWeek(2024, 52))},
+	{helper: "MaxE", element: "progress", applyer: must(prop.MaxE(prop.Number(100)))},
+	{helper: "MaxLength", element: "input", applyer: prop.MaxLength(80)},
+	{helper: "Media", element: "source", applyer: prop.Media(prop.NewMediaQuery().Screen().And().Width(600).And().Height(400))},
+	{helper: "Media", element: "link", applyer: prop.Media(prop.NewMediaQuery().Print().And().Orientation(prop.OrientationCaseLandscape).And().Grid(true))},
+	{helper: "Media", element: "style", applyer: prop.Media(prop.NewMediaQuery().All().And().DeviceWidth(800).And().DeviceHeight(600).And().AspectRatio(16, 9).And().DeviceAspectRatio(4, 3))},
+	{helper: "Media", element: "source", applyer: prop.Media(prop.NewMediaQuery().Not().TV().And().Color(8).And().ColorIndex(256).And().Monochrome(2).And().Resolution("300dpi").And().Scan(prop.ScanCaseProgressive))},
+	{helper: "Media", element: "source", applyer: prop.Media(prop.NewMediaQuery().Aural().Comma().Braille().Comma().Handheld().Comma().Projection().Comma().TTY())},
+	{helper: "Method", element: "form", applyer: prop.Method(prop.MethodCasePOST)},
+	{helper: "MethodE", element: "form", applyer: must(prop.MethodE(prop.MethodCasePOST))},
+	{helper: "MIMEType", element: "link", applyer: prop.MIMEType("text/css")},
+	{helper: "MIMEType", element: "source", applyer: prop.MIMEType(`video/mp4; codecs="avc1.4D401E, mp4a.40.2"`)},
+	{helper: "MIMETypeE", element: "link", applyer: must(prop.MIMETypeE("text/css"))},
+	{helper: "Min", element: "input", applyer: prop.Min(prop.Text("2024-01-02"))},
+	{helper: "Min", element: "input", applyer: prop.Min(prop.NewTimeOfDay(8, 0, 0))},
+	{helper: "MinE", element: "meter", applyer: must(prop.MinE(prop.Number(-1.5)))},
+	{helper: "Multiple", element: "select", applyer: prop.Multiple(true)},
+	{helper: "Muted", element: "video", applyer: prop.Muted(true)},
+	{helper: "Name", element: "meta", applyer: prop.Name(prop.NameCaseViewport)},
+	{helper: "NameE", element: "meta", applyer: must(prop.NameE(prop.NameCaseViewport))},
+	{helper: "Novalidate", element: "form", applyer: prop.Novalidate(true)},
+	{helper: "Open", element: "details", applyer: prop.Open(true)},
+	{helper: "Optimum", element: "meter", applyer: prop.Optimum(50)},
+	{helper: "Pattern", element: "input", applyer: prop.Pattern(regexp.MustCompile(`[0-9]{3}`))},
+	{helper: "PatternE", element: "input", applyer: must(prop.PatternE(regexp.MustCompile(`(?i)(?P<code>[a-z]{2})\.`)))},
+	{helper: "Placeholder", element: "input", applyer: prop.Placeholder("you@example.com")},
+	{helper: "Poster", element: "video", applyer: prop.Poster("/poster.png")},
+	{helper: "PosterE", element: "video", applyer: must(prop.PosterE("/poster.png"))},
+	{helper: "Preload", element: "audio", applyer: prop.Preload(prop.PreloadCaseMetadata)},
+	{helper: "PreloadE", element: "audio", applyer: must(prop.PreloadE(prop.PreloadCaseMetadata))},
+	{helper: "Readonly", element: "input", applyer: prop.Readonly(true)},
+	{helper: "ReferrerPolicy", element: "img", applyer: prop.ReferrerPolicy(prop.ReferrerPolicyCaseNoReferrer)},
+	{helper: "ReferrerPolicyE", element: "img", applyer: must(prop.ReferrerPolicyE(prop.ReferrerPolicyCaseNoReferrer))},
+	{helper: "Rel", element: "a", applyer: prop.Rel(prop.RelTokenNoOpener, prop.RelTokenNoReferrer, prop.RelTokenNoOpener, prop.RelTokenUGC)},
+	{helper: "Rel", element: "form", applyer: prop.Rel(prop.RelTokenNofollow)},
+	{helper: "Rel", element: "link", applyer: prop.Rel(prop.RelTokenPreload)},
+	{helper: "RelE", element: "form", applyer: must(prop.RelE("form", prop.RelTokenNoOpener, prop.RelTokenExternal))},
+	{helper: "Required", element: "input", applyer: prop.Required(true)},
+	{helper: "Reversed", element: "ol", applyer: prop.Reversed(true)},
+	{helper: "Rows", element: "textarea", applyer: prop.Rows(4)},
+	{helper: "RowSpan", element: "th", applyer: prop.RowSpan(3)},
+	{helper: "Sandbox", element: "iframe", applyer: prop.Sandbox(true)},
+	{helper: "Scope", element: "th", applyer: prop.Scope(prop.ScopeCaseCol)},
+	{helper: "ScopeE", element: "th", applyer: must(prop.ScopeE(prop.ScopeCaseCol))},
+	{helper: "ScriptType", element: "script", applyer: prop.ScriptType(prop.ScriptTypeCaseModule)},
+	{helper: "ScriptType", element: "script", applyer: prop.ScriptType("text/plain")},
+	{helper: "ScriptTypeE", element: "script", applyer: must(prop.ScriptTypeE(prop.ScriptTypeCaseModule))},
+	{helper: "Selected", element: "option", applyer: prop.Selected(true)},
+	{helper: "Shape", element: "area", applyer: prop.Shape(prop.ShapeCaseCircle)},
+	{helper: "ShapeE", element: "area", applyer: must(prop.ShapeE(prop.ShapeCaseCircle))},
+	{helper: "Size", element: "select", applyer: prop.Size(5)},
+	{helper: "Sizes", element: "img", applyer: prop.Sizes(prop.NewImageSizes().Group(prop.NewMediaQuerySize("50vw").MaxWidth("600px")).Default("100vw"))},
+	{helper: "Sizes", element: "link", applyer: prop.Sizes(prop.NewLinkSizes().Pair(16, 16).Pair(32, 32))},
+	{helper: "Span", element: "col", applyer: prop.Span(2)},
+	{helper: "SpellCheck", element: "textarea", applyer: prop.SpellCheck(true)},
+	{helper: "Src", element: "img", applyer: prop.Src("/cat.png")},
+	{helper: "Src", element: "img", applyer: prop.Src("data:image/png;base64,iVBORw0KGgo=")},
+	{helper: "SrcE", element: "img", applyer: must(prop.SrcE("/cat.png"))},
+	{helper: "SrcDoc", element: "iframe", applyer: prop.SrcDoc(prop.NewNode("p").Include(prop.NewTextNode("hi")))},
+	{helper: "SrcLang", element: "track", applyer: prop.SrcLang("en")},
+	{helper: "Srcset", element: "img", applyer: prop.Srcset(prop.NewSrcsetPair("/a.png").Width(480), prop.NewSrcsetPair("/b.png").Width(800))},
+	{helper: "SrcsetE", element: "source", applyer: must(prop.SrcsetE(prop.NewSrcsetPair("/a.png").PixelDensity(2)))},
+	{helper: "Start", element: "ol", applyer: prop.Start(-3)},
+	{helper: "Step", element: "input", applyer: prop.Step(5)},
+	{helper: "Step", element: "input", applyer: prop.Step(0)},
+	{helper: "TabIndex", element: "div", applyer: prop.TabIndex(-1)},
+	{helper: "Target", element: "a", applyer: prop.Target(prop.TargetCaseBlank)},
+	{helper: "TargetE", element: "a", applyer: must(prop.TargetE(prop.TargetCaseBlank))},
+	{helper: "Title", element: "abbr", applyer: prop.Title("HyperText Markup Language")},
+	{helper: "Translate", element: "span", applyer: prop.Translate(false)},
+	{helper: "UseMap", element: "img", applyer: prop.UseMap(prop.NewEntityRef("planets"))},
+	{helper: "Value", element: "input", applyer: prop.Value("42")},
+	{helper: "Value", element: "input", applyer: prop.Value(prop.NewMonth(2024, time.May))},
+	{helper: "ValueE", element: "meter", applyer: must(prop.ValueE(prop.Number(0.25)))},
+	{helper: "Width", element: "canvas", applyer: prop.Width(300)},
+	{helper: "Wrap", element: "textarea", applyer: prop.Wrap(prop.WrapCaseHard)},
+	{helper: "WrapE", element: "textarea", applyer: must(prop.WrapE(prop.WrapCaseHard))},
+}
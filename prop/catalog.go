@@ -0,0 +1,83 @@
+package prop
+
+import "github.com/Hand-of-Doom/Vecty-Props/spec"
+
+// AttributeInfo describes an attribute set by the helpers of this package
+type AttributeInfo struct {
+	// Name is the content attribute
+	Name string `json:"name"`
+	// Property is the DOM property, it is empty when the content attribute is set instead
+	Property string `json:"property"`
+	// Helpers are the functions that set the attribute
+	// ex: Autocomplete, Autofill, AutofillE
+	Helpers []string `json:"helpers"`
+	// Type is the Go type of the constants accepted by the helpers, it is empty for plain values
+	// ex: RelToken
+	Type string `json:"type,omitempty"`
+	// Value is the name of the value grammar
+	// ex: url, enum, token-list, boolean
+	Value string `json:"value"`
+	// Values are the keywords of an enumerated value or token list
+	Values []string `json:"values,omitempty"`
+	// ElementValues narrow down the values for some of the elements
+	// ex: the link types of <a>, <area>, <form> and <link>
+	ElementValues map[string][]string `json:"elementValues,omitempty"`
+	// Elements are the elements the attribute applies to, it is empty for global attributes
+	Elements []string `json:"elements,omitempty"`
+	Global   bool     `json:"global,omitempty"`
+	Boolean  bool     `json:"boolean,omitempty"`
+}
+
+// AppliesTo reports whether the attribute can be set on the element
+func (a AttributeInfo) AppliesTo(element string) bool {
+	return spec.Attribute{Global: a.Global, Elements: a.Elements}.AppliesTo(element)
+}
+
+// ValuesOf returns the values the attribute allows on the element
+func (a AttributeInfo) ValuesOf(element string) []string {
+	return spec.Attribute{Values: a.Values, ElementValues: a.ElementValues}.ValuesOf(element)
+}
+
+// Catalog returns every attribute supported by this package, sorted by name.
+// It is built from the same dataset the helpers are checked against, the result is a copy
+func Catalog() []AttributeInfo {
+	attrs := spec.Load().Attributes
+
+	catalog := make([]AttributeInfo, 0, len(attrs))
+	for _, a := range attrs {
+		var elementValues map[string][]string
+		if a.ElementValues != nil {
+			elementValues = make(map[string][]string, len(a.ElementValues))
+			for element, values := range a.ElementValues {
+				elementValues[element] = append([]string(nil), values...)
+			}
+		}
+
+		catalog = append(catalog, AttributeInfo{
+			Name:          a.Name,
+			Property:      a.Property,
+			Helpers:       append([]string(nil), a.Helpers...),
+			Type:          a.Type,
+			Value:         a.Value,
+			Values:        append([]string(nil), a.Values...),
+			ElementValues: elementValues,
+			Elements:      append([]string(nil), a.Elements...),
+			Global:        a.Global,
+			Boolean:       a.Boolean,
+		})
+	}
+
+	return catalog
+}
+
+// CatalogOf returns the attributes that can be set on the element, the global ones included
+func CatalogOf(element string) []AttributeInfo {
+	var attrs []AttributeInfo
+	for _, a := range Catalog() {
+		if a.AppliesTo(element) {
+			attrs = append(attrs, a)
+		}
+	}
+
+	return attrs
+}
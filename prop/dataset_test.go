@@ -0,0 +1,269 @@
+package prop_test
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/Hand-of-Doom/Vecty-Props/prop"
+	_ "github.com/Hand-of-Doom/Vecty-Props/prop/native"
+	"github.com/Hand-of-Doom/Vecty-Props/spec"
+	"github.com/hexops/vecty"
+)
+
+// unchecked are the helpers that set arbitrary attributes
+var unchecked = map[string]bool{
+	"On":     true,
+	"IDRefs": true,
+}
+
+// composite are the helpers that set several attributes of the dataset at once, every one of them is checked
+var composite = map[string][]string{
+	"ExternalLink":  {"href", "target", "rel", "referrerpolicy"},
+	"ExternalLinkE": {"href", "target", "rel", "referrerpolicy"},
+}
+
+// TestHelpers renders each case, then checks the attribute name, the element it is set on
+// and the value grammar for that element
+func TestHelpers(t *testing.T) {
+	ds := spec.Load()
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.helper+" on "+c.element, func(t *testing.T) {
+			if err := checkCase(ds, c); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+// TestCoverage checks that every exported helper is in the dataset and has a case
+func TestCoverage(t *testing.T) {
+	helpers, _, err := parseProp(".")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	covered := map[string]bool{}
+	for _, c := range cases {
+		covered[c.helper] = true
+	}
+
+	ds := spec.Load()
+	for _, helper := range helpers {
+		if _, ok := ds.Helper(helper); !ok && composite[helper] == nil {
+			t.Errorf("%s is not in the dataset", helper)
+		}
+		if !covered[helper] {
+			t.Errorf("%s has no case", helper)
+		}
+	}
+}
+
+// TestConstants checks the enum constants and the per element values of the dataset
+func TestConstants(t *testing.T) {
+	_, consts, err := parseProp(".")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, attr := range spec.Load().Attributes {
+		for element, values := range attr.ElementValues {
+			if !attr.AppliesTo(element) {
+				t.Errorf("%s has values for <%s>, which it doesn't apply to", attr.Name, element)
+			}
+			for _, value := range values {
+				if !contains(attr.Values, value) {
+					t.Errorf("%s allows %s on <%s>, which is not one of its values", attr.Name, value, element)
+				}
+			}
+		}
+
+		if attr.Type == "" {
+			continue
+		}
+
+		for _, c := range consts[attr.Type] {
+			if err := spec.Load().CheckValue(attr, c[1]); err != nil {
+				t.Errorf("%s: %s", c[0], err)
+			}
+		}
+	}
+}
+
+func checkCase(ds *spec.Dataset, c testCase) error {
+	if names, ok := composite[c.helper]; ok {
+		return checkComposite(ds, c, names)
+	}
+
+	want, ok := ds.Helper(c.helper)
+	if !ok {
+		return fmt.Errorf("not in the dataset")
+	}
+	if !want.AppliesTo(c.element) {
+		return fmt.Errorf("%s does not apply to <%s>", want.Name, c.element)
+	}
+
+	html, attrs, err := render(c)
+	if err != nil {
+		return err
+	}
+
+	if c.absent {
+		if len(attrs) != 0 {
+			return fmt.Errorf("%s: expected no attributes", html)
+		}
+
+		return nil
+	}
+	if len(attrs) != 1 {
+		return fmt.Errorf("%s: expected exactly the %s attribute", html, want.Name)
+	}
+	if attrs[0].Key() != want.Name {
+		return fmt.Errorf("%s: sets %s instead of %s", html, attrs[0].Key(), want.Name)
+	}
+
+	return ds.CheckValueOn(want, c.element, attrs[0].Value())
+}
+
+// checkComposite checks every attribute set by the case, it must be one of the names
+func checkComposite(ds *spec.Dataset, c testCase, names []string) error {
+	html, attrs, err := render(c)
+	if err != nil {
+		return err
+	}
+	if len(attrs) == 0 {
+		return fmt.Errorf("%s: expected some of %s", html, strings.Join(names, ", "))
+	}
+
+	for _, attr := range attrs {
+		if !contains(names, attr.Key()) {
+			return fmt.Errorf("%s: sets %s, which is not one of %s", html, attr.Key(), strings.Join(names, ", "))
+		}
+
+		want, ok := ds.Attribute(attr.Key())
+		if !ok {
+			return fmt.Errorf("%s is not in the dataset", attr.Key())
+		}
+		if !want.AppliesTo(c.element) {
+			return fmt.Errorf("%s does not apply to <%s>", want.Name, c.element)
+		}
+		if err := ds.CheckValueOn(want, c.element, attr.Value()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// render renders the case and returns the HTML and the attributes of the element
+func render(c testCase) (string, []*prop.Attr, error) {
+	var html strings.Builder
+	if err := prop.RenderHTML(&html, vecty.Tag(c.element, vecty.Markup(c.applyer))); err != nil {
+		return "", nil, err
+	}
+
+	tree, err := prop.ParseFakeDOM(strings.NewReader(html.String()))
+	if err != nil {
+		return "", nil, fmt.Errorf("%s: %s", html.String(), err)
+	}
+
+	var attrs []*prop.Attr
+	prop.Walk(tree, func(node prop.FakeDOM) bool {
+		if node, ok := node.(*prop.Node); ok {
+			attrs = node.Attrs()
+			return false
+		}
+
+		return true
+	})
+
+	return html.String(), attrs, nil
+}
+
+// parseProp returns the exported helpers and the constants grouped by their type
+func parseProp(dir string) ([]string, map[string][][2]string, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pkg, ok := pkgs["prop"]
+	if !ok {
+		return nil, nil, fmt.Errorf("no prop package in %s", dir)
+	}
+
+	var helpers []string
+	consts := map[string][][2]string{}
+
+	for _, file := range pkg.Files {
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv != nil || !decl.Name.IsExported() || unchecked[decl.Name.Name] ||
+					deprecated(decl.Doc) || !returnsApplyer(decl.Type) {
+					continue
+				}
+
+				helpers = append(helpers, decl.Name.Name)
+			case *ast.GenDecl:
+				if decl.Tok != token.CONST || deprecated(decl.Doc) {
+					continue
+				}
+
+				for _, s := range decl.Specs {
+					s := s.(*ast.ValueSpec)
+					typ, ok := s.Type.(*ast.Ident)
+					if !ok || deprecated(s.Doc) {
+						continue
+					}
+
+					for i, name := range s.Names {
+						lit, ok := s.Values[i].(*ast.BasicLit)
+						if !ok || lit.Kind != token.STRING {
+							continue
+						}
+
+						value, _ := strconv.Unquote(lit.Value)
+						consts[typ.Name] = append(consts[typ.Name], [2]string{name.Name, value})
+					}
+				}
+			}
+		}
+	}
+
+	return helpers, consts, nil
+}
+
+func returnsApplyer(fn *ast.FuncType) bool {
+	if fn.Results == nil || len(fn.Results.List) == 0 {
+		return false
+	}
+
+	sel, ok := fn.Results.List[0].Type.(*ast.SelectorExpr)
+
+	return ok && sel.Sel.Name == "Applyer"
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func deprecated(doc *ast.CommentGroup) bool {
+	return doc != nil && strings.Contains(doc.Text(), "Deprecated:")
+}
@@ -0,0 +1,437 @@
+package prop
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// DatetimeValue is a value in one of the HTML date and time microsyntaxes
+// ex: 2024-01-02, 2024-W05, 15:04, PT2H30M
+type DatetimeValue interface {
+	Validate() error
+	buildValue() (string, error)
+}
+
+// datetimeError is the error of a value, formatValue moves it to the attribute the value is set on
+func datetimeError(value, reason string) error {
+	return &ValueError{Attr: "datetime", Value: value, Reason: reason}
+}
+
+func validDate(year int, month time.Month, day int) error {
+	value := fmt.Sprintf("%04d-%02d-%02d", year, month, day)
+
+	if year < 1 {
+		return datetimeError(value, "the year must be greater than zero")
+	}
+	if month < time.January || month > time.December {
+		return datetimeError(value, "the month must be from 1 to 12")
+	}
+	if day < 1 || day > daysIn(year, month) {
+		return datetimeError(value, fmt.Sprintf("the day must be from 1 to %d", daysIn(year, month)))
+	}
+
+	return nil
+}
+
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// Date is a valid date string
+// ex: 2024-01-02
+type Date struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+func (v *Date) Validate() error {
+	_, err := v.buildValue()
+
+	return err
+}
+
+func (v *Date) buildValue() (string, error) {
+	if v == nil {
+		return "", datetimeError("", "no value")
+	}
+
+	if err := validDate(v.year, v.month, v.day); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%04d-%02d-%02d", v.year, v.month, v.day), nil
+}
+
+func NewDate(year int, month time.Month, day int) *Date {
+	return &Date{
+		year:  year,
+		month: month,
+		day:   day,
+	}
+}
+
+// DateOf takes the date of the time in its location
+func DateOf(t time.Time) *Date {
+	return NewDate(t.Date())
+}
+
+// Month is a valid month string
+// ex: 2024-01
+type Month struct {
+	year  int
+	month time.Month
+}
+
+func (v *Month) Validate() error {
+	_, err := v.buildValue()
+
+	return err
+}
+
+func (v *Month) buildValue() (string, error) {
+	if v == nil {
+		return "", datetimeError("", "no value")
+	}
+
+	if err := validDate(v.year, v.month, 1); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%04d-%02d", v.year, v.month), nil
+}
+
+func NewMonth(year int, month time.Month) *Month {
+	return &Month{
+		year:  year,
+		month: month,
+	}
+}
+
+func MonthOf(t time.Time) *Month {
+	return NewMonth(t.Year(), t.Month())
+}
+
+// Week is a valid week string, weeks are numbered as in ISO 8601
+// ex: 2024-W05
+type Week struct {
+	year int
+	week int
+}
+
+func (v *Week) Validate() error {
+	_, err := v.buildValue()
+
+	return err
+}
+
+func (v *Week) buildValue() (string, error) {
+	if v == nil {
+		return "", datetimeError("", "no value")
+	}
+
+	value := fmt.Sprintf("%04d-W%02d", v.year, v.week)
+
+	if v.year < 1 {
+		return "", datetimeError(value, "the year must be greater than zero")
+	}
+	if weeks := weeksIn(v.year); v.week < 1 || v.week > weeks {
+		return "", datetimeError(value, fmt.Sprintf("the week must be from 1 to %d", weeks))
+	}
+
+	return value, nil
+}
+
+// weeksIn returns 53 for the years whose December 28 is in the 53rd week
+func weeksIn(year int) int {
+	_, week := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+
+	return week
+}
+
+func NewWeek(year, week int) *Week {
+	return &Week{
+		year: year,
+		week: week,
+	}
+}
+
+func WeekOf(t time.Time) *Week {
+	return NewWeek(t.ISOWeek())
+}
+
+// TimeOfDay is a valid time string, seconds and milliseconds are written only when they are not zero
+// ex: 15:04, 15:04:05, 15:04:05.120
+type TimeOfDay struct {
+	hour        int
+	minute      int
+	second      int
+	millisecond int
+}
+
+// Millisecond sets the fraction of the second
+func (v *TimeOfDay) Millisecond(value int) *TimeOfDay {
+	v.millisecond = value
+
+	return v
+}
+
+func (v *TimeOfDay) Validate() error {
+	_, err := v.buildValue()
+
+	return err
+}
+
+func (v *TimeOfDay) buildValue() (string, error) {
+	if v == nil {
+		return "", datetimeError("", "no value")
+	}
+
+	value := fmt.Sprintf("%02d:%02d", v.hour, v.minute)
+	if v.second != 0 || v.millisecond != 0 {
+		value += fmt.Sprintf(":%02d", v.second)
+	}
+	if v.millisecond != 0 {
+		value += fmt.Sprintf(".%03d", v.millisecond)
+	}
+
+	switch {
+	case v.hour < 0 || v.hour > 23:
+		return "", datetimeError(value, "the hour must be from 0 to 23")
+	case v.minute < 0 || v.minute > 59:
+		return "", datetimeError(value, "the minute must be from 0 to 59")
+	case v.second < 0 || v.second > 59:
+		return "", datetimeError(value, "the second must be from 0 to 59")
+	case v.millisecond < 0 || v.millisecond > 999:
+		return "", datetimeError(value, "the millisecond must be from 0 to 999")
+	}
+
+	return value, nil
+}
+
+func NewTimeOfDay(hour, minute, second int) *TimeOfDay {
+	return &TimeOfDay{
+		hour:   hour,
+		minute: minute,
+		second: second,
+	}
+}
+
+// TimeOf takes the time of day of the time in its location, the precision is a millisecond
+func TimeOf(t time.Time) *TimeOfDay {
+	return NewTimeOfDay(t.Clock()).Millisecond(t.Nanosecond() / int(time.Millisecond))
+}
+
+// LocalDatetime is a valid normalized local date and time string, it has no time zone
+// ex: 2024-01-02T15:04
+type LocalDatetime struct {
+	date *Date
+	time *TimeOfDay
+}
+
+func (v *LocalDatetime) Validate() error {
+	_, err := v.buildValue()
+
+	return err
+}
+
+func (v *LocalDatetime) buildValue() (string, error) {
+	if v == nil {
+		return "", datetimeError("", "no value")
+	}
+
+	date, err := v.date.buildValue()
+	if err != nil {
+		return "", err
+	}
+	clock, err := v.time.buildValue()
+	if err != nil {
+		return "", err
+	}
+
+	return date + "T" + clock, nil
+}
+
+func NewLocalDatetime(date *Date, time *TimeOfDay) *LocalDatetime {
+	return &LocalDatetime{
+		date: date,
+		time: time,
+	}
+}
+
+// LocalDatetimeOf takes the date and the time of the time in its location and drops the location
+func LocalDatetimeOf(t time.Time) *LocalDatetime {
+	return NewLocalDatetime(DateOf(t), TimeOf(t))
+}
+
+// GlobalDatetime is a valid global date and time string, it has the time zone offset
+// ex: 2024-01-02T15:04Z, 2024-01-02T15:04:05+03:00
+type GlobalDatetime struct {
+	time time.Time
+}
+
+func (v *GlobalDatetime) Validate() error {
+	_, err := v.buildValue()
+
+	return err
+}
+
+func (v *GlobalDatetime) buildValue() (string, error) {
+	if v == nil {
+		return "", datetimeError("", "no value")
+	}
+
+	local, err := LocalDatetimeOf(v.time).buildValue()
+	if err != nil {
+		return "", err
+	}
+
+	_, offset := v.time.Zone()
+	if offset == 0 {
+		return local + "Z", nil
+	}
+
+	sign := "+"
+	if offset < 0 {
+		sign = "-"
+		offset = -offset
+	}
+
+	return fmt.Sprintf("%s%s%02d:%02d", local, sign, offset/3600, offset%3600/60), nil
+}
+
+func NewGlobalDatetime(t time.Time) *GlobalDatetime {
+	return &GlobalDatetime{
+		time: t,
+	}
+}
+
+// YearlessDate is a valid yearless date string
+// ex: 12-25
+type YearlessDate struct {
+	month time.Month
+	day   int
+}
+
+func (v *YearlessDate) Validate() error {
+	_, err := v.buildValue()
+
+	return err
+}
+
+func (v *YearlessDate) buildValue() (string, error) {
+	if v == nil {
+		return "", datetimeError("", "no value")
+	}
+
+	// a leap year allows February 29
+	if err := validDate(2000, v.month, v.day); err != nil {
+		return "", datetimeError(fmt.Sprintf("%02d-%02d", v.month, v.day), err.(*ValueError).Reason)
+	}
+
+	return fmt.Sprintf("%02d-%02d", v.month, v.day), nil
+}
+
+func NewYearlessDate(month time.Month, day int) *YearlessDate {
+	return &YearlessDate{
+		month: month,
+		day:   day,
+	}
+}
+
+// Duration is a valid duration string in the ISO 8601 form, the precision is a millisecond
+// ex: PT2H30M, P1DT12H
+type Duration struct {
+	duration time.Duration
+}
+
+func (v *Duration) Validate() error {
+	_, err := v.buildValue()
+
+	return err
+}
+
+func (v *Duration) buildValue() (string, error) {
+	if v == nil {
+		return "", datetimeError("", "no value")
+	}
+
+	d := v.duration.Round(time.Millisecond)
+	if d < 0 {
+		return "", datetimeError(d.String(), "the duration must not be negative")
+	}
+
+	days := d / (24 * time.Hour)
+	d -= days * 24 * time.Hour
+	hours := d / time.Hour
+	d -= hours * time.Hour
+	minutes := d / time.Minute
+	d -= minutes * time.Minute
+
+	value := "P"
+	if days != 0 {
+		value += fmt.Sprintf("%dD", days)
+	}
+	if hours != 0 || minutes != 0 || d != 0 || days == 0 {
+		value += "T"
+	}
+	if hours != 0 {
+		value += fmt.Sprintf("%dH", hours)
+	}
+	if minutes != 0 {
+		value += fmt.Sprintf("%dM", minutes)
+	}
+	if d != 0 || value == "PT" {
+		seconds := fmt.Sprintf("%d.%03d", d/time.Second, d%time.Second/time.Millisecond)
+		value += strings.TrimSuffix(strings.TrimRight(seconds, "0"), ".") + "S"
+	}
+
+	return value, nil
+}
+
+func NewDuration(d time.Duration) *Duration {
+	return &Duration{
+		duration: d,
+	}
+}
+
+// FormValue is a value of min, max and value: a Number, a Text or a DatetimeValue
+// ex: Min(Number(0)), Max(NewDate(2024, time.December, 31)), Value(Text("blue"))
+type FormValue interface {
+	buildValue() (string, error)
+}
+
+// Number is a valid floating-point number, NaN and the infinities are not
+type Number float64
+
+func (v Number) buildValue() (string, error) {
+	if math.IsNaN(float64(v)) || math.IsInf(float64(v), 0) {
+		return "", &ValueError{Attr: "value", Value: formatFloat(float64(v)), Reason: "not a finite number"}
+	}
+
+	return formatFloat(float64(v)), nil
+}
+
+// Text is a value written as is
+type Text string
+
+func (v Text) buildValue() (string, error) {
+	return string(v), nil
+}
+
+// formatValue writes the value in its microsyntax, the attribute of the error is attr.
+// The values are pointers except Number and Text, their buildValue methods report a nil one
+func formatValue(attr string, value FormValue) (string, error) {
+	if value == nil {
+		return "", &ValueError{Attr: attr, Reason: "no value"}
+	}
+
+	tpl, err := value.buildValue()
+	if err, ok := err.(*ValueError); ok {
+		return "", &ValueError{Attr: attr, Value: err.Value, Reason: err.Reason}
+	}
+
+	return tpl, err
+}
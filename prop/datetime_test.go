@@ -0,0 +1,104 @@
+package prop
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/hexops/vecty"
+)
+
+func TestFormValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		value FormValue
+		want  string
+		err   bool
+	}{
+		{name: "number", value: Number(0.5), want: "0.5"},
+		{name: "negative number", value: Number(-10), want: "-10"},
+		{name: "NaN", value: Number(math.NaN()), err: true},
+		{name: "infinity", value: Number(math.Inf(1)), err: true},
+		{name: "text", value: Text("2024-01-02"), want: "2024-01-02"},
+		{name: "date", value: NewDate(2024, time.February, 29), want: "2024-02-29"},
+		{name: "bad date", value: NewDate(2023, time.February, 29), err: true},
+		{name: "week", value: NewWeek(2020, 53), want: "2020-W53"},
+		{name: "bad week", value: NewWeek(2021, 53), err: true},
+		{name: "time", value: NewTimeOfDay(9, 30, 5).Millisecond(250), want: "09:30:05.250"},
+		{name: "bad time", value: NewTimeOfDay(24, 0, 0), err: true},
+		{name: "global", value: NewGlobalDatetime(time.Date(2024, 1, 2, 15, 4, 0, 0, time.FixedZone("", -3*60*60))),
+			want: "2024-01-02T15:04-03:00"},
+		{name: "duration", value: NewDuration(36*time.Hour + 1500*time.Millisecond), want: "P1DT12H1.5S"},
+		{name: "negative duration", value: NewDuration(-time.Second), err: true},
+		{name: "nil", err: true},
+		{name: "nil date", value: (*Date)(nil), err: true},
+		{name: "nil duration", value: (*Duration)(nil), err: true},
+		{name: "local without a date", value: NewLocalDatetime(nil, NewTimeOfDay(9, 0, 0)), err: true},
+		{name: "local without a time", value: NewLocalDatetime(NewDate(2024, 1, 2), nil), err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := formatValue("min", tt.value)
+			if (err != nil) != tt.err {
+				t.Fatalf("formatValue error = %v, want error %v", err, tt.err)
+			}
+			if got != tt.want {
+				t.Errorf("formatValue = %q, want %q", got, tt.want)
+			}
+
+			var valueErr *ValueError
+			if err != nil && (!errors.As(err, &valueErr) || valueErr.Attr != "min") {
+				t.Errorf("formatValue error = %v, want a *ValueError of min", err)
+			}
+		})
+	}
+}
+
+func TestFormValueHelpers(t *testing.T) {
+	tests := []struct {
+		name    string
+		element string
+		build   func() (vecty.Applyer, error)
+		want    string
+	}{
+		{name: "DatetimeE", element: "time", build: func() (vecty.Applyer, error) { return DatetimeE(NewMonth(2024, time.March)) },
+			want: `<time datetime="2024-03"></time>`},
+		{name: "MinE", element: "input", build: func() (vecty.Applyer, error) { return MinE(NewTimeOfDay(8, 0, 0)) },
+			want: `<input min="08:00">`},
+		{name: "MaxE", element: "meter", build: func() (vecty.Applyer, error) { return MaxE(Number(0.5)) },
+			want: `<meter max="0.5"></meter>`},
+		{name: "ValueE", element: "input", build: func() (vecty.Applyer, error) { return ValueE(NewWeek(2024, 5)) },
+			want: `<input value="2024-W05">`},
+		{name: "ValueE of a string", element: "option", build: func() (vecty.Applyer, error) { return ValueE("blue") },
+			want: `<option value="blue"></option>`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			applyer, err := tt.build()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := renderAttrs(t, tt.element, applyer); got != tt.want {
+				t.Errorf("%s = %s, want %s", tt.name, got, tt.want)
+			}
+		})
+	}
+
+	for name, build := range map[string]func() (vecty.Applyer, error){
+		"DatetimeE":        func() (vecty.Applyer, error) { return DatetimeE(NewYearlessDate(time.February, 30)) },
+		"MinE":             func() (vecty.Applyer, error) { return MinE(Number(math.NaN())) },
+		"MaxE":             func() (vecty.Applyer, error) { return MaxE(NewDate(2024, 13, 1)) },
+		"ValueE":           func() (vecty.Applyer, error) { return ValueE(NewTimeOfDay(9, 60, 0)) },
+		"DatetimeE of nil": func() (vecty.Applyer, error) { return DatetimeE((*Date)(nil)) },
+		"MinE of nil":      func() (vecty.Applyer, error) { return MinE((*Date)(nil)) },
+		"MaxE of nil":      func() (vecty.Applyer, error) { return MaxE((*LocalDatetime)(nil)) },
+		"ValueE of nil":    func() (vecty.Applyer, error) { return ValueE((*Week)(nil)) },
+	} {
+		if _, err := build(); err == nil {
+			t.Errorf("%s takes an invalid value", name)
+		}
+	}
+}
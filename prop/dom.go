@@ -0,0 +1,167 @@
+package prop
+
+import (
+	"fmt"
+
+	"github.com/hexops/vecty"
+)
+
+// domAttr describes how a content attribute reaches the DOM
+type domAttr struct {
+	// property is the name of the DOM property, the content attribute is set when it is empty
+	property string
+	// boolean attributes are either present or absent
+	boolean bool
+	// keywords are the values written for false and true when the attribute is enumerated instead of boolean
+	keywords [2]string
+}
+
+// domAttrs is the mapping table for every attribute set by this package.
+// Attributes without a writable DOM property (form, list, sizes, sandbox...) are set as content attributes
+var domAttrs = map[string]domAttr{
+	"accept":          {property: "accept"},
+	"accept-charset":  {property: "acceptCharset"},
+	"accesskey":       {property: "accessKey"},
+	"action":          {property: "action"},
+	"alt":             {property: "alt"},
+	"async":           {property: "async", boolean: true},
+	"autocomplete":    {property: "autocomplete"},
+	"autofocus":       {property: "autofocus", boolean: true},
+	"autoplay":        {property: "autoplay", boolean: true},
+	"charset":         {},
+	"checked":         {property: "checked", boolean: true},
+	"cite":            {property: "cite"},
+	"cols":            {property: "cols"},
+	"colspan":         {property: "colSpan"},
+	"content":         {property: "content"},
+	"contenteditable": {keywords: [2]string{"false", "true"}},
+	"controls":        {property: "controls", boolean: true},
+	"coords":          {property: "coords"},
+	"data":            {property: "data"},
+	"datetime":        {property: "dateTime"},
+	"default":         {property: "default", boolean: true},
+	"defer":           {property: "defer", boolean: true},
+	"dir":             {property: "dir"},
+	"dirname":         {property: "dirName"},
+	"disabled":        {property: "disabled", boolean: true},
+	"download":        {boolean: true},
+	"draggable":       {property: "draggable", keywords: [2]string{"false", "true"}},
+	"enctype":         {property: "enctype"},
+	"for":             {property: "htmlFor"},
+	"form":            {},
+	"formaction":      {property: "formAction"},
+	"headers":         {property: "headers"},
+	"height":          {property: "height"},
+	"hidden":          {property: "hidden", boolean: true},
+	"high":            {property: "high"},
+	"href":            {property: "href"},
+	"hreflang":        {property: "hreflang"},
+	"http-equiv":      {property: "httpEquiv"},
+	"id":              {property: "id"},
+	"ismap":           {property: "isMap", boolean: true},
+	"kind":            {property: "kind"},
+	"label":           {property: "label"},
+	"lang":            {property: "lang"},
+	"list":            {},
+	"loop":            {property: "loop", boolean: true},
+	"low":             {property: "low"},
+	"max":             {property: "max"},
+	"maxlength":       {property: "maxLength"},
+	"media":           {property: "media"},
+	"method":          {property: "method"},
+	"min":             {property: "min"},
+	"multiple":        {property: "multiple", boolean: true},
+	"muted":           {property: "muted", boolean: true},
+	"name":            {property: "name"},
+	"novalidate":      {property: "noValidate", boolean: true},
+	"open":            {property: "open", boolean: true},
+	"optimum":         {property: "optimum"},
+	"pattern":         {property: "pattern"},
+	"placeholder":     {property: "placeholder"},
+	"poster":          {property: "poster"},
+	"preload":         {property: "preload"},
+	"readonly":        {property: "readOnly", boolean: true},
+	"referrerpolicy":  {property: "referrerPolicy"},
+	"rel":             {property: "rel"},
+	"required":        {property: "required", boolean: true},
+	"reversed":        {property: "reversed", boolean: true},
+	"rows":            {property: "rows"},
+	"rowspan":         {property: "rowSpan"},
+	"sandbox":         {boolean: true},
+	"scope":           {property: "scope"},
+	"selected":        {property: "selected", boolean: true},
+	"shape":           {property: "shape"},
+	"size":            {property: "size"},
+	"sizes":           {},
+	"span":            {property: "span"},
+	"spellcheck":      {property: "spellcheck", keywords: [2]string{"false", "true"}},
+	"src":             {property: "src"},
+	"srcdoc":          {property: "srcdoc"},
+	"srclang":         {property: "srclang"},
+	"srcset":          {property: "srcset"},
+	"start":           {property: "start"},
+	"step":            {property: "step"},
+	"tabindex":        {property: "tabIndex"},
+	"target":          {property: "target"},
+	"title":           {property: "title"},
+	"translate":       {property: "translate", keywords: [2]string{"no", "yes"}},
+	"type":            {property: "type"},
+	"usemap":          {property: "useMap"},
+	"value":           {property: "value"},
+	"width":           {property: "width"},
+	"wrap":            {property: "wrap"},
+}
+
+// propertyAttrs maps DOM properties back to their content attributes
+var propertyAttrs = map[string]string{
+	"className":       "class",
+	"crossOrigin":     "crossorigin",
+	"defaultChecked":  "checked",
+	"defaultSelected": "selected",
+	"defaultValue":    "value",
+	"formEnctype":     "formenctype",
+	"formMethod":      "formmethod",
+	"formNoValidate":  "formnovalidate",
+	"formTarget":      "formtarget",
+	"minLength":       "minlength",
+	"noModule":        "nomodule",
+	"playsInline":     "playsinline",
+	"referrerPolicy":  "referrerpolicy",
+}
+
+func init() {
+	for name, attr := range domAttrs {
+		if attr.property != "" && attr.property != name {
+			propertyAttrs[attr.property] = name
+		}
+	}
+}
+
+// applyAttr sets the attribute the way the mapping table says, through the DOM property or the content attribute
+func applyAttr(name string, value interface{}) vecty.Applyer {
+	attr, ok := domAttrs[name]
+	if !ok {
+		panic("prop: attribute " + name + " is missing from the mapping table")
+	}
+
+	if attr.property != "" {
+		return vecty.Property(attr.property, value)
+	}
+
+	flag, ok := value.(bool)
+	switch {
+	case !ok:
+		return vecty.Attribute(name, fmt.Sprint(value))
+	case attr.keywords != [2]string{}:
+		if flag {
+			return vecty.Attribute(name, attr.keywords[1])
+		}
+
+		return vecty.Attribute(name, attr.keywords[0])
+	case flag:
+		return vecty.Attribute(name, "")
+	default:
+		// an absent applyer removes the attribute on the next render
+		return applyerFunc(func(h *vecty.HTML) {})
+	}
+}
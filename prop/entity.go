@@ -0,0 +1,224 @@
+package prop
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hexops/vecty"
+)
+
+// EntityRef is a reference to an element by its id
+// ex: ID(ref) on an <input> and For(ref) on its <label>
+type EntityRef struct {
+	id    string
+	scope *IDScope
+}
+
+// NewEntityRef makes a reference to an id that is not tracked by any IDScope
+func NewEntityRef(id string) EntityRef {
+	return EntityRef{
+		id: id,
+	}
+}
+
+func (r EntityRef) String() string {
+	return r.id
+}
+
+func (r EntityRef) declare() {
+	if r.scope != nil {
+		r.scope.declare(r.id)
+	}
+}
+
+func (r EntityRef) reference(attr string) {
+	if r.scope != nil {
+		r.scope.reference(attr, r.id)
+	}
+}
+
+// IDScope mints unique ids for a single render pass and tracks which of them were rendered.
+// Call Reset before every render pass and Check after it.
+// An id is recorded when its applyer is applied, so a render pass is every element of the scope:
+// a component vecty doesn't render again, because of SkipRender or a Rerender of another component,
+// doesn't record its ids after Reset and Check reports the references to them as dangling.
+// Check after a full render, such as RenderHTML or a Rerender of the root component
+type IDScope struct {
+	mu         sync.Mutex
+	prefix     string
+	minted     map[string]uint64
+	declared   map[string]uint64
+	referenced map[string][]string
+}
+
+var idHintPattern = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+
+// Mint returns a new id that is unique within the scope, the hint makes it readable
+// ex: NewIDScope("signup").Mint("email") => signup-email-1
+func (s *IDScope) Mint(hint string) EntityRef {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	hint = strings.Trim(idHintPattern.ReplaceAllString(hint, "-"), "-")
+	if hint == "" {
+		hint = "id"
+	}
+	s.minted[hint]++
+
+	id := fmt.Sprintf("%s-%d", hint, s.minted[hint])
+	if s.prefix != "" {
+		id = s.prefix + "-" + id
+	}
+
+	return EntityRef{
+		id:    id,
+		scope: s,
+	}
+}
+
+// Ref returns a reference to an id that was not minted by the scope but is still checked by it
+func (s *IDScope) Ref(id string) EntityRef {
+	return EntityRef{
+		id:    id,
+		scope: s,
+	}
+}
+
+func (s *IDScope) declare(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.declared[id]++
+}
+
+func (s *IDScope) reference(attr, id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.referenced[id] = append(s.referenced[id], attr)
+}
+
+// Reset forgets everything the scope has seen, so the next render pass mints the same ids again.
+// The next pass must render every element of the scope, see IDScope
+func (s *IDScope) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.minted = map[string]uint64{}
+	s.declared = map[string]uint64{}
+	s.referenced = map[string][]string{}
+}
+
+// Check reports the ids that were rendered more than once and the references to ids that were never rendered,
+// it is only valid after a full render pass, see IDScope
+func (s *IDScope) Check() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var errs IDScopeErrors
+
+	ids := make([]string, 0, len(s.declared))
+	for id := range s.declared {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		if count := s.declared[id]; count > 1 {
+			errs = append(errs, &DuplicateIDError{ID: id, Count: count})
+		}
+	}
+
+	ids = ids[:0]
+	for id := range s.referenced {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		if s.declared[id] != 0 {
+			continue
+		}
+		for _, attr := range s.referenced[id] {
+			errs = append(errs, &DanglingRefError{Attr: attr, ID: id})
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errs
+}
+
+func NewIDScope(prefix string) *IDScope {
+	return &IDScope{
+		prefix:     prefix,
+		minted:     map[string]uint64{},
+		declared:   map[string]uint64{},
+		referenced: map[string][]string{},
+	}
+}
+
+// DuplicateIDError means that the same id was rendered more than once in a scope
+type DuplicateIDError struct {
+	ID    string
+	Count uint64
+}
+
+func (e *DuplicateIDError) Error() string {
+	return fmt.Sprintf("id %q is used by %d elements", e.ID, e.Count)
+}
+
+// DanglingRefError means that an attribute references an id that was never rendered in a scope
+type DanglingRefError struct {
+	Attr string
+	ID   string
+}
+
+func (e *DanglingRefError) Error() string {
+	return fmt.Sprintf("%s references id %q but no element has it", e.Attr, e.ID)
+}
+
+// IDScopeErrors is every problem found by IDScope.Check
+type IDScopeErrors []error
+
+func (e IDScopeErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+func declareID(ref EntityRef, applyer vecty.Applyer) vecty.Applyer {
+	return applyerFunc(func(h *vecty.HTML) {
+		ref.declare()
+		applyer.Apply(h)
+	})
+}
+
+func referenceIDs(attr string, applyer vecty.Applyer, refs ...EntityRef) vecty.Applyer {
+	return applyerFunc(func(h *vecty.HTML) {
+		for _, ref := range refs {
+			ref.reference(attr)
+		}
+		applyer.Apply(h)
+	})
+}
+
+// IDRefs sets the attribute to the ids separated by spaces and reports the references to the scopes of the ids.
+// It is meant for attributes that are not covered by this package
+// ex: IDRefs("aria-labelledby", title, subtitle)
+func IDRefs(attr string, refs ...EntityRef) vecty.Applyer {
+	ids := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		ids = append(ids, ref.id)
+	}
+
+	return referenceIDs(attr, vecty.Attribute(attr, strings.Join(ids, " ")), refs...)
+}
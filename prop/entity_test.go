@@ -0,0 +1,76 @@
+package prop
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hexops/vecty"
+)
+
+func TestIDScopeMint(t *testing.T) {
+	scope := NewIDScope("signup")
+
+	for _, tt := range []struct {
+		hint string
+		want string
+	}{
+		{hint: "email", want: "signup-email-1"},
+		{hint: "email", want: "signup-email-2"},
+		{hint: "first name", want: "signup-first-name-1"},
+		{hint: "!!", want: "signup-id-1"},
+	} {
+		if got := scope.Mint(tt.hint).String(); got != tt.want {
+			t.Errorf("Mint(%q) = %q, want %q", tt.hint, got, tt.want)
+		}
+	}
+
+	scope.Reset()
+	if got := scope.Mint("email").String(); got != "signup-email-1" {
+		t.Errorf("Mint after Reset = %q, want signup-email-1", got)
+	}
+}
+
+func TestIDScopeCheck(t *testing.T) {
+	render := func(t *testing.T, elements ...vecty.MarkupOrChild) {
+		t.Helper()
+
+		if err := RenderHTML(&strings.Builder{}, vecty.Tag("div", elements...)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	scope := NewIDScope("")
+	email := scope.Mint("email")
+	name := scope.Mint("name")
+	label := func() *vecty.HTML { return vecty.Tag("label", vecty.Markup(For(email))) }
+	input := func() *vecty.HTML { return vecty.Tag("input", vecty.Markup(ID(email))) }
+
+	render(t, label(), input())
+	if err := scope.Check(); err != nil {
+		t.Errorf("Check = %v, want nil", err)
+	}
+
+	scope.Reset()
+	render(t, input(), input(), vecty.Tag("label", vecty.Markup(For(name))))
+
+	var errs IDScopeErrors
+	if err := scope.Check(); !errors.As(err, &errs) {
+		t.Fatalf("Check error = %v, want IDScopeErrors", err)
+	}
+	want := IDScopeErrors{
+		&DuplicateIDError{ID: "email-1", Count: 2},
+		&DanglingRefError{Attr: "for", ID: "name-1"},
+	}
+	if !reflect.DeepEqual(errs, want) {
+		t.Errorf("Check = %v, want %v", errs, want)
+	}
+
+	// the input of the last pass is not rendered again, so its id is not recorded
+	scope.Reset()
+	render(t, label())
+	if err := scope.Check(); err == nil {
+		t.Error("Check after a partial render = nil, want a dangling reference")
+	}
+}
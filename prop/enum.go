@@ -0,0 +1,73 @@
+package prop
+
+import (
+	"errors"
+	"mime"
+	"strings"
+
+	"github.com/Hand-of-Doom/Vecty-Props/spec"
+)
+
+// checkValue checks the value against the grammar of the attribute in the dataset
+func checkValue(attr, value string) error {
+	return checkValueOn(attr, "", value)
+}
+
+// checkValueOn is checkValue that also checks the keywords allowed on the element, such as the link types of <form>
+func checkValueOn(attr, element, value string) error {
+	a, ok := spec.Load().Attribute(attr)
+	if !ok {
+		panic("prop: attribute " + attr + " is missing from the dataset")
+	}
+
+	err := spec.Load().CheckValueOn(a, element, value)
+
+	var grammarErr *spec.GrammarError
+	if errors.As(err, &grammarErr) {
+		return &ValueError{Attr: attr, Value: value, Reason: grammarErr.Reason}
+	}
+
+	return err
+}
+
+// MIME is a media type with optional parameters
+// ex: text/css, video/mp4; codecs="avc1.4D401E, mp4a.40.2"
+type MIME string
+
+// ParseMIME returns the value when it is a MIME type
+func ParseMIME(value string) (MIME, error) {
+	c := MIME(value)
+	if err := c.check(); err != nil {
+		return "", err
+	}
+
+	return c, nil
+}
+
+// Valid reports whether the value is a type and a subtype with optional parameters
+func (c MIME) Valid() bool {
+	return validMIME(string(c))
+}
+
+func (c MIME) String() string {
+	return string(c)
+}
+
+func (c MIME) check() error {
+	if c.Valid() {
+		return nil
+	}
+
+	return &ValueError{Attr: "type", Value: string(c), Reason: "expected a MIME type such as text/css"}
+}
+
+func validMIME(value string) bool {
+	essence, _, err := mime.ParseMediaType(value)
+	if err != nil {
+		return false
+	}
+
+	typ, subtype, ok := strings.Cut(essence, "/")
+
+	return ok && typ != "" && subtype != "" && !strings.Contains(subtype, "/")
+}
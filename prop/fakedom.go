@@ -0,0 +1,459 @@
+package prop
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hexops/vecty"
+)
+
+var (
+	attrNamePattern = regexp.MustCompile(`^[^\s"'>/=\x00-\x1f\x7f]+$`)
+	tagNamePattern  = regexp.MustCompile(`^[A-Za-z][^\s"'<>/=\x00-\x1f\x7f]*$`)
+
+	attrEscaper = strings.NewReplacer(
+		"&", "&amp;",
+		"\"", "&quot;",
+		"<", "&lt;",
+		">", "&gt;",
+		"\u00a0", "&nbsp;",
+	)
+	textEscaper = strings.NewReplacer(
+		"&", "&amp;",
+		"<", "&lt;",
+		">", "&gt;",
+		"\u00a0", "&nbsp;",
+	)
+)
+
+var (
+	voidElements = map[string]bool{
+		"area": true, "base": true, "basefont": true, "bgsound": true, "br": true, "col": true,
+		"embed": true, "frame": true, "hr": true, "img": true, "input": true, "keygen": true,
+		"link": true, "meta": true, "param": true, "source": true, "track": true, "wbr": true,
+	}
+	rawTextElements = map[string]bool{
+		"iframe": true, "noembed": true, "noframes": true, "noscript": true,
+		"plaintext": true, "script": true, "style": true, "xmp": true,
+	}
+)
+
+type Attr struct {
+	key,
+	value string
+	boolean bool
+}
+
+func (b *Attr) Key() string {
+	return b.key
+}
+
+func (b *Attr) Value() string {
+	return b.value
+}
+
+// Apply sets the attribute on a vecty element
+func (b *Attr) Apply(h *vecty.HTML) {
+	vecty.Attribute(b.key, b.value).Apply(h)
+}
+
+func (b *Attr) build() string {
+	if b.boolean {
+		return b.key
+	}
+
+	return fmt.Sprintf(`%s="%s"`, b.key, attrEscaper.Replace(b.value))
+}
+
+// NewAttr makes an attribute, the value is escaped when the tree is built.
+// It panics if the key is not a valid attribute name
+func NewAttr(key, value string) *Attr {
+	attr, err := NewAttrE(key, value)
+	if err != nil {
+		panic(err)
+	}
+
+	return attr
+}
+
+// NewAttrE is NewAttr that returns an error instead of panicking
+func NewAttrE(key, value string) (*Attr, error) {
+	if !attrNamePattern.MatchString(key) {
+		return nil, &AttrNameError{Name: key}
+	}
+
+	return &Attr{
+		key:   key,
+		value: value,
+	}, nil
+}
+
+// NewBoolAttr makes a boolean attribute, it is rendered without a value.
+// It panics if the key is not a valid attribute name
+func NewBoolAttr(key string) *Attr {
+	attr := NewAttr(key, "")
+	attr.boolean = true
+
+	return attr
+}
+
+// AttrNameError means that a string can't be used as an attribute name
+type AttrNameError struct {
+	Name string
+}
+
+func (e *AttrNameError) Error() string {
+	return fmt.Sprintf("%q is not a valid attribute name", e.Name)
+}
+
+// TagNameError means that a string can't be used as an element name
+type TagNameError struct {
+	Name string
+}
+
+func (e *TagNameError) Error() string {
+	return fmt.Sprintf("%q is not a valid element name", e.Name)
+}
+
+// RawTextError means that a text would end the raw text element it is in, such as </script> inside <script>
+type RawTextError struct {
+	Element string
+	Text    string
+}
+
+func (e *RawTextError) Error() string {
+	return fmt.Sprintf("the text %q would close <%s>", e.Text, e.Element)
+}
+
+// CommentError means that a text can't be the text of a comment, the HTML tokenizer ends a comment
+// that starts with > or -> right away
+type CommentError struct {
+	Text string
+}
+
+func (e *CommentError) Error() string {
+	return fmt.Sprintf("the comment %q would end at its start", e.Text)
+}
+
+type FakeDOM interface {
+	buildTree() string
+}
+
+type RawNode struct {
+	tree string
+}
+
+func (b *RawNode) Include(nodes ...FakeDOM) *RawNode {
+	for _, node := range nodes {
+		b.tree += node.buildTree()
+	}
+
+	return b
+}
+
+// HTML returns the markup of the node as it is
+func (b *RawNode) HTML() string {
+	return b.tree
+}
+
+func (b *RawNode) buildTree() string {
+	return b.tree
+}
+
+// NewRawNode makes a node from trusted markup, it is never escaped
+func NewRawNode(html string) *RawNode {
+	return &RawNode{
+		tree: html,
+	}
+}
+
+func NewEmptyNode(nodes ...Node) *RawNode {
+	var tree string
+	for _, node := range nodes {
+		tree += node.buildTree()
+	}
+
+	return &RawNode{
+		tree: tree,
+	}
+}
+
+// TextNode is a text whose content is escaped, use it for user data.
+// Inside <script>, <style> and the other raw text elements it is written as is,
+// so Node.Include refuses a text that contains the end tag of the element
+type TextNode struct {
+	text string
+}
+
+func (b *TextNode) Text() string {
+	return b.text
+}
+
+func (b *TextNode) buildTree() string {
+	return textEscaper.Replace(b.text)
+}
+
+func NewTextNode(text string) *TextNode {
+	return &TextNode{
+		text: text,
+	}
+}
+
+// CommentNode is an HTML comment
+type CommentNode struct {
+	text string
+}
+
+func (b *CommentNode) Text() string {
+	return b.text
+}
+
+// commentEndReplacer breaks the sequences that end a comment
+var commentEndReplacer = strings.NewReplacer("-->", "-- >", "--!>", "--! >")
+
+func (b *CommentNode) buildTree() string {
+	return "<!--" + commentEndReplacer.Replace(b.text) + "-->"
+}
+
+// NewCommentNode makes a comment, it panics if the text starts with > or ->, see NewCommentNodeE
+func NewCommentNode(text string) *CommentNode {
+	node, err := NewCommentNodeE(text)
+	if err != nil {
+		panic(err)
+	}
+
+	return node
+}
+
+// NewCommentNodeE is NewCommentNode that returns an error instead of panicking
+func NewCommentNodeE(text string) (*CommentNode, error) {
+	if strings.HasPrefix(text, ">") || strings.HasPrefix(text, "->") {
+		return nil, &CommentError{Text: text}
+	}
+
+	return &CommentNode{
+		text: text,
+	}, nil
+}
+
+type Node struct {
+	name  string
+	attrs []*Attr
+	nodes []FakeDOM
+}
+
+// Include appends the nodes, it panics when a text would close the raw text element, see IncludeE
+func (b *Node) Include(nodes ...FakeDOM) *Node {
+	if _, err := b.IncludeE(nodes...); err != nil {
+		panic(err)
+	}
+
+	return b
+}
+
+// IncludeE is Include that returns an error instead of panicking, no node is appended then
+func (b *Node) IncludeE(nodes ...FakeDOM) (*Node, error) {
+	for _, node := range nodes {
+		if err := b.checkRawText(node); err != nil {
+			return b, err
+		}
+	}
+	b.nodes = append(b.nodes, nodes...)
+
+	return b, nil
+}
+
+func (b *Node) Name() string {
+	return b.name
+}
+
+func (b *Node) Attrs() []*Attr {
+	return b.attrs
+}
+
+// Attr returns the value of the attribute and whether the node has it
+func (b *Node) Attr(key string) (string, bool) {
+	for _, attr := range b.attrs {
+		if strings.EqualFold(attr.key, key) {
+			return attr.value, true
+		}
+	}
+
+	return "", false
+}
+
+// SetAttr replaces the value of the attribute or adds it if the node doesn't have it
+func (b *Node) SetAttr(key, value string) *Node {
+	for _, attr := range b.attrs {
+		if strings.EqualFold(attr.key, key) {
+			attr.value = value
+			attr.boolean = false
+
+			return b
+		}
+	}
+	b.attrs = append(b.attrs, NewAttr(key, value))
+
+	return b
+}
+
+func (b *Node) RemoveAttr(key string) *Node {
+	attrs := b.attrs[:0]
+	for _, attr := range b.attrs {
+		if !strings.EqualFold(attr.key, key) {
+			attrs = append(attrs, attr)
+		}
+	}
+	b.attrs = attrs
+
+	return b
+}
+
+func (b *Node) Nodes() []FakeDOM {
+	return b.nodes
+}
+
+func (b *Node) single() bool {
+	return voidElements[strings.ToLower(b.name)]
+}
+
+// raw reports whether the text inside the element is written as is (<script>, <style>...)
+func (b *Node) raw() bool {
+	return rawTextElements[strings.ToLower(b.name)]
+}
+
+// checkRawText reports a text that contains the end tag of the raw text element it is in
+func (b *Node) checkRawText(node FakeDOM) error {
+	if text, ok := node.(*TextNode); ok && b.closedBy(text.text) {
+		return &RawTextError{Element: b.name, Text: text.text}
+	}
+
+	return nil
+}
+
+// closedBy reports whether the text would end the raw text element, <plaintext> has no end tag
+func (b *Node) closedBy(text string) bool {
+	name := strings.ToLower(b.name)
+
+	return b.raw() && name != "plaintext" && strings.Contains(strings.ToLower(text), "</"+name)
+}
+
+func (b *Node) buildTree() string {
+	tpl := fmt.Sprintf("<%s", b.name)
+
+	for _, attr := range b.attrs {
+		tpl += " " + attr.build()
+	}
+	tpl += ">"
+
+	// void elements can't have any content
+	if b.single() {
+		return tpl
+	}
+
+	for _, node := range b.nodes {
+		// a text that would close the element is escaped, IncludeE and FromVecty refuse it before
+		if text, ok := node.(*TextNode); ok && b.raw() && !b.closedBy(text.text) {
+			tpl += text.text
+			continue
+		}
+
+		tpl += node.buildTree()
+	}
+
+	return tpl + fmt.Sprintf("</%s>", b.name)
+}
+
+// NewNode makes an element, the attributes are either *Attr or the applyers of this package.
+// It panics if the name is not a valid element name
+// ex: NewNode("a", Href("/"), NewAttr("title", "Home"))
+func NewNode(name string, attrs ...vecty.Applyer) *Node {
+	node, err := NewNodeE(name, attrs...)
+	if err != nil {
+		panic(err)
+	}
+
+	return node
+}
+
+// NewNodeE is NewNode that returns an error instead of panicking
+func NewNodeE(name string, attrs ...vecty.Applyer) (*Node, error) {
+	if !tagNamePattern.MatchString(name) {
+		return nil, &TagNameError{Name: name}
+	}
+
+	applied, err := applyAttrs(name, attrs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Node{
+		name:  name,
+		attrs: applied,
+	}, nil
+}
+
+// Document is the root of a FakeDOM tree
+type Document struct {
+	doctype bool
+	nodes   []FakeDOM
+}
+
+// Doctype specifies whether the document starts with <!DOCTYPE html>
+func (b *Document) Doctype(flag bool) *Document {
+	b.doctype = flag
+
+	return b
+}
+
+func (b *Document) Nodes() []FakeDOM {
+	return b.nodes
+}
+
+func (b *Document) Include(nodes ...FakeDOM) *Document {
+	b.nodes = append(b.nodes, nodes...)
+
+	return b
+}
+
+func (b *Document) buildTree() string {
+	var tpl string
+	if b.doctype {
+		tpl = "<!DOCTYPE html>"
+	}
+
+	for _, node := range b.nodes {
+		tpl += node.buildTree()
+	}
+
+	return tpl
+}
+
+// NewDocument makes a document that starts with <!DOCTYPE html>
+func NewDocument(nodes ...FakeDOM) *Document {
+	return &Document{
+		doctype: true,
+		nodes:   nodes,
+	}
+}
+
+// Walk calls visit for the tree and every node inside it, depth first.
+// The nodes inside a node are skipped when visit returns false
+func Walk(tree FakeDOM, visit func(node FakeDOM) bool) {
+	if !visit(tree) {
+		return
+	}
+
+	var nodes []FakeDOM
+	switch tree := tree.(type) {
+	case *Node:
+		nodes = tree.nodes
+	case *Document:
+		nodes = tree.nodes
+	}
+
+	for _, node := range nodes {
+		Walk(node, visit)
+	}
+}
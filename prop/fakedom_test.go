@@ -0,0 +1,134 @@
+package prop
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hexops/vecty"
+)
+
+func TestNewNodeE(t *testing.T) {
+	tests := []struct {
+		name string
+		ok   bool
+	}{
+		{name: "div", ok: true},
+		{name: "my-element", ok: true},
+		{name: "svg:rect", ok: true},
+		{name: ""},
+		{name: "1div"},
+		{name: "x onload=alert(1)"},
+		{name: "a>"},
+		{name: "a/b"},
+	}
+
+	for _, tt := range tests {
+		_, err := NewNodeE(tt.name)
+
+		var nameErr *TagNameError
+		if tt.ok && err != nil || !tt.ok && !errors.As(err, &nameErr) {
+			t.Errorf("NewNodeE(%q) error = %v, want ok %v", tt.name, err, tt.ok)
+		}
+	}
+}
+
+func TestRawText(t *testing.T) {
+	tests := []struct {
+		element string
+		text    string
+		want    string
+		err     bool
+	}{
+		{element: "script", text: "if (a < b && c > d) {}", want: "<script>if (a < b && c > d) {}</script>"},
+		{element: "script", text: "</script><img src=x onerror=alert(1)>", err: true},
+		{element: "script", text: "</SCRIPT >", err: true},
+		{element: "style", text: "a > b {}", want: "<style>a > b {}</style>"},
+		{element: "style", text: "</style><script>", err: true},
+		// the end tag of another element doesn't close it
+		{element: "style", text: "</script>", want: "<style></script></style>"},
+		{element: "div", text: "</div>", want: "<div>&lt;/div&gt;</div>"},
+	}
+
+	for _, tt := range tests {
+		node, err := NewNode(tt.element).IncludeE(NewTextNode(tt.text))
+
+		var rawErr *RawTextError
+		if tt.err {
+			if !errors.As(err, &rawErr) {
+				t.Errorf("<%s> with %q: error = %v, want a RawTextError", tt.element, tt.text, err)
+			}
+			if len(node.Nodes()) != 0 {
+				t.Errorf("<%s> with %q: the text was appended", tt.element, tt.text)
+			}
+
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := node.buildTree(); got != tt.want {
+			t.Errorf("<%s> with %q = %s, want %s", tt.element, tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestRawTextFromVecty(t *testing.T) {
+	_, err := FromVecty(vecty.Tag("script", vecty.Text("</script><img src=x onerror=alert(1)>")))
+
+	var rawErr *RawTextError
+	if !errors.As(err, &rawErr) {
+		t.Errorf("FromVecty error = %v, want a RawTextError", err)
+	}
+
+	// a node that escaped the checks is still not closed early
+	node := &Node{name: "script", nodes: []FakeDOM{NewTextNode("</script><b>")}}
+	if got := node.buildTree(); strings.Count(strings.ToLower(got), "</script") != 1 {
+		t.Errorf("buildTree = %s, the text closes the element", got)
+	}
+}
+
+func TestCommentNode(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+		err  bool
+	}{
+		{text: " a ", want: "<!-- a -->"},
+		{text: "a --> b", want: "<!--a -- > b-->"},
+		{text: "a --!> b", want: "<!--a --! > b-->"},
+		{text: "a --->", want: "<!--a --- >-->"},
+		{text: "-a", want: "<!---a-->"},
+		{text: ">a", err: true},
+		{text: "->a", err: true},
+	}
+
+	for _, tt := range tests {
+		node, err := NewCommentNodeE(tt.text)
+
+		var commentErr *CommentError
+		if tt.err {
+			if !errors.As(err, &commentErr) {
+				t.Errorf("NewCommentNodeE(%q) error = %v, want a CommentError", tt.text, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("NewCommentNodeE(%q) error = %v", tt.text, err)
+		}
+
+		got := node.buildTree()
+		if got != tt.want {
+			t.Errorf("NewCommentNodeE(%q) = %s, want %s", tt.text, got, tt.want)
+		}
+
+		// the comment is parsed back as a single comment
+		tree, err := ParseFakeDOM(strings.NewReader(got))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if again := tree.buildTree(); again != got {
+			t.Errorf("%s parses to %s", got, again)
+		}
+	}
+}
@@ -0,0 +1,556 @@
+package prop
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/hexops/vecty"
+)
+
+// constraints are min, max, step and value of an <input> converted to numbers in the units of its type,
+// as in the HTML constraint validation
+type constraints struct {
+	typ InputTypeCase
+	// defaultStep is used when the step is not set
+	defaultStep *big.Rat
+	unit        string
+
+	min, max, value        *big.Rat
+	minTpl, maxTpl, valTpl string
+	step                   *big.Rat
+	stepTpl                string
+	err                    error
+}
+
+func (c *constraints) set(attr string, tpl string, number *big.Rat, err error) {
+	if err != nil {
+		if c.err == nil {
+			c.err = err
+		}
+		return
+	}
+
+	switch attr {
+	case "min":
+		c.min, c.minTpl = number, tpl
+	case "max":
+		c.max, c.maxTpl = number, tpl
+	case "value":
+		c.value, c.valTpl = number, tpl
+	}
+}
+
+// setStep sets the step in the units of the type, zero means any
+func (c *constraints) setStep(step float64) {
+	if math.IsNaN(step) || math.IsInf(step, 0) {
+		if c.err == nil {
+			c.err = &ValueError{Attr: "step", Value: formatFloat(step), Reason: "not a finite number"}
+		}
+		return
+	}
+
+	if step < 0 {
+		if c.err == nil {
+			c.err = &ValueError{Attr: "step", Value: formatFloat(step), Reason: "the step must be greater than zero"}
+		}
+		return
+	}
+
+	if step == 0 {
+		c.step, c.stepTpl = new(big.Rat), "any"
+		return
+	}
+
+	c.stepTpl = formatFloat(step)
+	c.step, _ = new(big.Rat).SetString(c.stepTpl)
+}
+
+func (c *constraints) validate() error {
+	if c.err != nil {
+		return c.err
+	}
+
+	if c.min != nil && c.max != nil && c.min.Cmp(c.max) > 0 {
+		return &ValueError{Attr: "max", Value: c.maxTpl, Reason: "max is less than min " + c.minTpl}
+	}
+
+	if c.value == nil {
+		return nil
+	}
+	if c.min != nil && c.value.Cmp(c.min) < 0 {
+		return &ValueError{Attr: "value", Value: c.valTpl, Reason: "value is less than min " + c.minTpl}
+	}
+	if c.max != nil && c.value.Cmp(c.max) > 0 {
+		return &ValueError{Attr: "value", Value: c.valTpl, Reason: "value is greater than max " + c.maxTpl}
+	}
+
+	if c.offStep(c.value) {
+		_, stepTpl := c.stepOf()
+
+		return &ValueError{
+			Attr:   "value",
+			Value:  c.valTpl,
+			Reason: fmt.Sprintf("value is not a multiple of the step %s %s from %s", stepTpl, c.unit, c.baseTpl()),
+		}
+	}
+
+	return nil
+}
+
+// stepOf returns the step in effect, it is zero for any
+func (c *constraints) stepOf() (*big.Rat, string) {
+	if c.step == nil {
+		return c.defaultStep, c.defaultStep.RatString()
+	}
+
+	return c.step, c.stepTpl
+}
+
+// offStep reports whether the number is not a whole number of steps away from the step base
+func (c *constraints) offStep(number *big.Rat) bool {
+	step, _ := c.stepOf()
+	if step.Sign() == 0 {
+		return false
+	}
+
+	return !new(big.Rat).Quo(new(big.Rat).Sub(number, c.base()), step).IsInt()
+}
+
+// base is the step base: the min, the value when there is no min, or zero
+func (c *constraints) base() *big.Rat {
+	switch {
+	case c.min != nil:
+		return c.min
+	case c.value != nil:
+		return c.value
+	}
+
+	return new(big.Rat)
+}
+
+func (c *constraints) baseTpl() string {
+	switch {
+	case c.min != nil:
+		return c.minTpl
+	case c.value != nil:
+		return c.valTpl
+	}
+
+	return "zero"
+}
+
+func (c *constraints) build() (vecty.Applyer, error) {
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+
+	markup := []vecty.Applyer{InputType(c.typ)}
+	if c.min != nil {
+		markup = append(markup, Min(Text(c.minTpl)))
+	}
+	if c.max != nil {
+		markup = append(markup, Max(Text(c.maxTpl)))
+	}
+	if c.step != nil {
+		markup = append(markup, applyAttr("step", c.stepTpl))
+	}
+	if c.value != nil {
+		markup = append(markup, Value(Text(c.valTpl)))
+	}
+
+	return vecty.Markup(markup...), nil
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+func decimal(attr string, value float64) (string, *big.Rat, error) {
+	tpl := formatFloat(value)
+
+	number, ok := new(big.Rat).SetString(tpl)
+	if !ok {
+		return tpl, nil, &ValueError{Attr: attr, Value: tpl, Reason: "not a finite number"}
+	}
+
+	return tpl, number, nil
+}
+
+// steppable is a DatetimeValue of an input type with min, max and step
+type steppable interface {
+	DatetimeValue
+	// number converts the value to the units of the step of its input type
+	number() *big.Rat
+}
+
+// datetimeNumber returns the value in its microsyntax and as a number, a nil value is an error of attr
+func datetimeNumber(attr string, value steppable) (string, *big.Rat, error) {
+	tpl, err := formatValue(attr, value)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return tpl, value.number(), nil
+}
+
+// number is the count of days since January 1, 1970
+func (v *Date) number() *big.Rat {
+	return big.NewRat(time.Date(v.year, v.month, v.day, 0, 0, 0, 0, time.UTC).Unix()/(24*60*60), 1)
+}
+
+// number is the count of months since January 1970
+func (v *Month) number() *big.Rat {
+	return big.NewRat(int64(v.year-1970)*12+int64(v.month-1), 1)
+}
+
+// number is the count of weeks since Monday, December 29, 1969
+func (v *Week) number() *big.Rat {
+	// the Monday of the first week is the one before January 4
+	jan4 := time.Date(v.year, time.January, 4, 0, 0, 0, 0, time.UTC)
+	monday := jan4.AddDate(0, 0, -((int(jan4.Weekday())+6)%7)+(v.week-1)*7)
+
+	return big.NewRat((monday.Unix()/(24*60*60)+3)/7, 1)
+}
+
+// number is the count of seconds since midnight
+func (v *TimeOfDay) number() *big.Rat {
+	seconds := big.NewRat(int64(v.hour*3600+v.minute*60+v.second), 1)
+
+	return seconds.Add(seconds, big.NewRat(int64(v.millisecond), 1000))
+}
+
+// number is the count of seconds since January 1, 1970
+func (v *LocalDatetime) number() *big.Rat {
+	seconds := new(big.Rat).Mul(v.date.number(), big.NewRat(24*60*60, 1))
+
+	return seconds.Add(seconds, v.time.number())
+}
+
+// NumberInput builds the constraints of <input type="number">, the units are decimals
+// ex: NewNumberInput().Min(0).Step(0.01).Build()
+type NumberInput struct {
+	c *constraints
+}
+
+func (b *NumberInput) Min(value float64) *NumberInput {
+	tpl, number, err := decimal("min", value)
+	b.c.set("min", tpl, number, err)
+
+	return b
+}
+
+func (b *NumberInput) Max(value float64) *NumberInput {
+	tpl, number, err := decimal("max", value)
+	b.c.set("max", tpl, number, err)
+
+	return b
+}
+
+// Step sets the granularity, zero means any
+func (b *NumberInput) Step(value float64) *NumberInput {
+	b.c.setStep(value)
+
+	return b
+}
+
+func (b *NumberInput) Value(value float64) *NumberInput {
+	tpl, number, err := decimal("value", value)
+	b.c.set("value", tpl, number, err)
+
+	return b
+}
+
+// Validate reports min greater than max and a value out of the range or off the step
+func (b *NumberInput) Validate() error {
+	return b.c.validate()
+}
+
+// Build returns Type, Min, Max, Step and Value of the input
+func (b *NumberInput) Build() (vecty.Applyer, error) {
+	return b.c.build()
+}
+
+func NewNumberInput() *NumberInput {
+	return &NumberInput{
+		c: &constraints{typ: InputTypeCaseNumber, defaultStep: big.NewRat(1, 1), unit: "units"},
+	}
+}
+
+// RangeInput builds the constraints of <input type="range">, the units are decimals
+type RangeInput struct {
+	c *constraints
+}
+
+func (b *RangeInput) Min(value float64) *RangeInput {
+	tpl, number, err := decimal("min", value)
+	b.c.set("min", tpl, number, err)
+
+	return b
+}
+
+func (b *RangeInput) Max(value float64) *RangeInput {
+	tpl, number, err := decimal("max", value)
+	b.c.set("max", tpl, number, err)
+
+	return b
+}
+
+// Step sets the granularity, zero means any
+func (b *RangeInput) Step(value float64) *RangeInput {
+	b.c.setStep(value)
+
+	return b
+}
+
+func (b *RangeInput) Value(value float64) *RangeInput {
+	tpl, number, err := decimal("value", value)
+	b.c.set("value", tpl, number, err)
+
+	return b
+}
+
+func (b *RangeInput) Validate() error {
+	return b.c.validate()
+}
+
+func (b *RangeInput) Build() (vecty.Applyer, error) {
+	return b.c.build()
+}
+
+// NewRangeInput starts with the defaults of the range input, from 0 to 100
+func NewRangeInput() *RangeInput {
+	b := &RangeInput{
+		c: &constraints{typ: InputTypeCaseRange, defaultStep: big.NewRat(1, 1), unit: "units"},
+	}
+
+	return b.Min(0).Max(100)
+}
+
+// DateInput builds the constraints of <input type="date">, the step is in days
+type DateInput struct {
+	c *constraints
+}
+
+func (b *DateInput) date(attr string, value *Date) *DateInput {
+	tpl, number, err := datetimeNumber(attr, value)
+	b.c.set(attr, tpl, number, err)
+
+	return b
+}
+
+func (b *DateInput) Min(value *Date) *DateInput {
+	return b.date("min", value)
+}
+
+func (b *DateInput) Max(value *Date) *DateInput {
+	return b.date("max", value)
+}
+
+// Step sets the granularity in days, zero means any
+func (b *DateInput) Step(days uint64) *DateInput {
+	b.c.setStep(float64(days))
+
+	return b
+}
+
+func (b *DateInput) Value(value *Date) *DateInput {
+	return b.date("value", value)
+}
+
+func (b *DateInput) Validate() error {
+	return b.c.validate()
+}
+
+func (b *DateInput) Build() (vecty.Applyer, error) {
+	return b.c.build()
+}
+
+func NewDateInput() *DateInput {
+	return &DateInput{
+		c: &constraints{typ: InputTypeCaseDate, defaultStep: big.NewRat(1, 1), unit: "days"},
+	}
+}
+
+// MonthInput builds the constraints of <input type="month">, the step is in months
+type MonthInput struct {
+	c *constraints
+}
+
+func (b *MonthInput) month(attr string, value *Month) *MonthInput {
+	tpl, number, err := datetimeNumber(attr, value)
+	b.c.set(attr, tpl, number, err)
+
+	return b
+}
+
+func (b *MonthInput) Min(value *Month) *MonthInput {
+	return b.month("min", value)
+}
+
+func (b *MonthInput) Max(value *Month) *MonthInput {
+	return b.month("max", value)
+}
+
+// Step sets the granularity in months, zero means any
+func (b *MonthInput) Step(months uint64) *MonthInput {
+	b.c.setStep(float64(months))
+
+	return b
+}
+
+func (b *MonthInput) Value(value *Month) *MonthInput {
+	return b.month("value", value)
+}
+
+func (b *MonthInput) Validate() error {
+	return b.c.validate()
+}
+
+func (b *MonthInput) Build() (vecty.Applyer, error) {
+	return b.c.build()
+}
+
+func NewMonthInput() *MonthInput {
+	return &MonthInput{
+		c: &constraints{typ: InputTypeCaseMonth, defaultStep: big.NewRat(1, 1), unit: "months"},
+	}
+}
+
+// WeekInput builds the constraints of <input type="week">, the step is in weeks
+type WeekInput struct {
+	c *constraints
+}
+
+func (b *WeekInput) week(attr string, value *Week) *WeekInput {
+	tpl, number, err := datetimeNumber(attr, value)
+	b.c.set(attr, tpl, number, err)
+
+	return b
+}
+
+func (b *WeekInput) Min(value *Week) *WeekInput {
+	return b.week("min", value)
+}
+
+func (b *WeekInput) Max(value *Week) *WeekInput {
+	return b.week("max", value)
+}
+
+// Step sets the granularity in weeks, zero means any
+func (b *WeekInput) Step(weeks uint64) *WeekInput {
+	b.c.setStep(float64(weeks))
+
+	return b
+}
+
+func (b *WeekInput) Value(value *Week) *WeekInput {
+	return b.week("value", value)
+}
+
+func (b *WeekInput) Validate() error {
+	return b.c.validate()
+}
+
+func (b *WeekInput) Build() (vecty.Applyer, error) {
+	return b.c.build()
+}
+
+func NewWeekInput() *WeekInput {
+	return &WeekInput{
+		c: &constraints{typ: InputTypeCaseWeek, defaultStep: big.NewRat(1, 1), unit: "weeks"},
+	}
+}
+
+// TimeInput builds the constraints of <input type="time">, the step is in seconds
+// ex: NewTimeInput().Min(NewTimeOfDay(9, 0, 0)).Step(900).Build()
+type TimeInput struct {
+	c *constraints
+}
+
+func (b *TimeInput) time(attr string, value *TimeOfDay) *TimeInput {
+	tpl, number, err := datetimeNumber(attr, value)
+	b.c.set(attr, tpl, number, err)
+
+	return b
+}
+
+func (b *TimeInput) Min(value *TimeOfDay) *TimeInput {
+	return b.time("min", value)
+}
+
+func (b *TimeInput) Max(value *TimeOfDay) *TimeInput {
+	return b.time("max", value)
+}
+
+// Step sets the granularity in seconds, zero means any
+func (b *TimeInput) Step(seconds float64) *TimeInput {
+	b.c.setStep(seconds)
+
+	return b
+}
+
+func (b *TimeInput) Value(value *TimeOfDay) *TimeInput {
+	return b.time("value", value)
+}
+
+func (b *TimeInput) Validate() error {
+	return b.c.validate()
+}
+
+func (b *TimeInput) Build() (vecty.Applyer, error) {
+	return b.c.build()
+}
+
+func NewTimeInput() *TimeInput {
+	return &TimeInput{
+		c: &constraints{typ: InputTypeCaseTime, defaultStep: big.NewRat(60, 1), unit: "seconds"},
+	}
+}
+
+// DatetimeLocalInput builds the constraints of <input type="datetime-local">, the step is in seconds
+type DatetimeLocalInput struct {
+	c *constraints
+}
+
+func (b *DatetimeLocalInput) datetime(attr string, value *LocalDatetime) *DatetimeLocalInput {
+	tpl, number, err := datetimeNumber(attr, value)
+	b.c.set(attr, tpl, number, err)
+
+	return b
+}
+
+func (b *DatetimeLocalInput) Min(value *LocalDatetime) *DatetimeLocalInput {
+	return b.datetime("min", value)
+}
+
+func (b *DatetimeLocalInput) Max(value *LocalDatetime) *DatetimeLocalInput {
+	return b.datetime("max", value)
+}
+
+// Step sets the granularity in seconds, zero means any
+func (b *DatetimeLocalInput) Step(seconds float64) *DatetimeLocalInput {
+	b.c.setStep(seconds)
+
+	return b
+}
+
+func (b *DatetimeLocalInput) Value(value *LocalDatetime) *DatetimeLocalInput {
+	return b.datetime("value", value)
+}
+
+func (b *DatetimeLocalInput) Validate() error {
+	return b.c.validate()
+}
+
+func (b *DatetimeLocalInput) Build() (vecty.Applyer, error) {
+	return b.c.build()
+}
+
+func NewDatetimeLocalInput() *DatetimeLocalInput {
+	return &DatetimeLocalInput{
+		c: &constraints{typ: InputTypeCaseDatetimeLocal, defaultStep: big.NewRat(60, 1), unit: "seconds"},
+	}
+}
@@ -0,0 +1,78 @@
+package prop
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/hexops/vecty"
+)
+
+func TestInputConstraints(t *testing.T) {
+	tests := []struct {
+		name  string
+		input interface {
+			Build() (vecty.Applyer, error)
+		}
+		want string
+		err  bool
+	}{
+		{name: "value without min is the step base", input: NewNumberInput().Value(0.5),
+			want: `<input type="number" value="0.5">`},
+		{name: "min is the step base", input: NewNumberInput().Min(0.5).Value(1.5),
+			want: `<input min="0.5" type="number" value="1.5">`},
+		{name: "value off the step from min", input: NewNumberInput().Min(0).Value(0.5), err: true},
+		{name: "decimal step", input: NewNumberInput().Min(0).Step(0.01).Value(0.3),
+			want: `<input min="0" step="0.01" type="number" value="0.3">`},
+		{name: "any step", input: NewNumberInput().Min(0).Step(0).Value(0.123),
+			want: `<input min="0" step="any" type="number" value="0.123">`},
+		{name: "NaN step", input: NewNumberInput().Step(math.NaN()), err: true},
+		{name: "infinite step", input: NewRangeInput().Step(math.Inf(1)), err: true},
+		{name: "negative step", input: NewNumberInput().Step(-1), err: true},
+		{name: "NaN value", input: NewNumberInput().Value(math.NaN()), err: true},
+		{name: "min greater than max", input: NewNumberInput().Min(10).Max(1), err: true},
+		{name: "value greater than max", input: NewNumberInput().Max(1).Value(2), err: true},
+		{name: "range defaults", input: NewRangeInput().Value(50),
+			want: `<input max="100" min="0" type="range" value="50">`},
+		{name: "time step from min", input: NewTimeInput().Min(NewTimeOfDay(9, 0, 0)).Step(900).Value(NewTimeOfDay(9, 15, 0)),
+			want: `<input min="09:00" step="900" type="time" value="09:15">`},
+		{name: "time off the default step", input: NewTimeInput().Min(NewTimeOfDay(9, 0, 0)).Value(NewTimeOfDay(9, 0, 30)), err: true},
+		{name: "date", input: NewDateInput().Min(NewDate(2024, time.January, 1)).Step(7).Value(NewDate(2024, time.January, 15)),
+			want: `<input min="2024-01-01" step="7" type="date" value="2024-01-15">`},
+		{name: "nil date min", input: NewDateInput().Min(nil).Value(NewDate(2024, time.January, 15)), err: true},
+		{name: "nil month max", input: NewMonthInput().Max(nil), err: true},
+		{name: "nil week value", input: NewWeekInput().Value(nil), err: true},
+		{name: "nil time min", input: NewTimeInput().Min(nil).Step(900), err: true},
+		{name: "local datetime without a date", input: NewDatetimeLocalInput().Value(NewLocalDatetime(nil, NewTimeOfDay(9, 0, 0))), err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			applyer, err := tt.input.Build()
+			if (err != nil) != tt.err {
+				t.Fatalf("Build error = %v, want error %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+
+			if got := renderAttrs(t, "input", applyer); got != tt.want {
+				t.Errorf("Build = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInputNilValues(t *testing.T) {
+	for name, validate := range map[string]func() error{
+		"DateInput":          NewDateInput().Min(nil).Validate,
+		"MonthInput":         NewMonthInput().Value((*Month)(nil)).Validate,
+		"WeekInput":          NewWeekInput().Max(nil).Validate,
+		"TimeInput":          NewTimeInput().Value(nil).Validate,
+		"DatetimeLocalInput": NewDatetimeLocalInput().Min(NewLocalDatetime(NewDate(2024, time.January, 1), nil)).Validate,
+	} {
+		if err := validate(); err == nil {
+			t.Errorf("%s.Validate takes a nil value", name)
+		}
+	}
+}
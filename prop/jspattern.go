@@ -0,0 +1,611 @@
+package prop
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// jsStepLimit bounds the backtracking of a single match, a sample that needs more steps is reported as an error
+const jsStepLimit = 1 << 20
+
+var errJSStepLimit = errors.New("the sample needs too much backtracking")
+
+type jsOp int
+
+const (
+	jsChar jsOp = iota
+	jsConcat
+	jsAlternate
+	jsRepeat
+	jsLookahead
+	jsLookbehind
+	jsBeginText
+	jsEndText
+	jsWordBoundary
+	jsNoWordBoundary
+)
+
+// jsNode is a part of a JS RegExp parsed by compileJSPattern, groups are kept as their content
+type jsNode struct {
+	op       jsOp
+	class    func(r rune) bool
+	sub      []*jsNode
+	min, max int
+	lazy     bool
+	negated  bool
+}
+
+// jsPattern is a RegExp compiled the way browsers compile the pattern attribute: with the v flag and without i, m or s
+type jsPattern struct {
+	root *jsNode
+}
+
+// compileJSPattern parses the source of a JS RegExp with the v flag, the result runs on the code points of a string
+// the way the backtracking engines of browsers do. Backreferences and property escapes are not supported
+func compileJSPattern(source string) (*jsPattern, error) {
+	p := &jsParser{src: []rune(source)}
+
+	root, err := p.alternate()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.src) {
+		return nil, p.fail("unmatched )")
+	}
+
+	return &jsPattern{root: root}, nil
+}
+
+// matchWhole reports whether the whole value matches, as the pattern attribute is wrapped in ^(?: and )$
+func (re *jsPattern) matchWhole(value string) (bool, error) {
+	m := &jsMatcher{input: []rune(value)}
+	ok := m.match(re.root, 0, func(end int) bool {
+		return end == len(m.input)
+	})
+	if m.steps > jsStepLimit {
+		return false, errJSStepLimit
+	}
+
+	return ok, nil
+}
+
+type jsMatcher struct {
+	input []rune
+	steps int
+}
+
+// match runs the node at pos and calls next with the end of every way it matches, in the order of JS, until next succeeds
+func (m *jsMatcher) match(n *jsNode, pos int, next func(end int) bool) bool {
+	if m.steps++; m.steps > jsStepLimit {
+		return false
+	}
+
+	switch n.op {
+	case jsChar:
+		return pos < len(m.input) && n.class(m.input[pos]) && next(pos+1)
+	case jsConcat:
+		return m.concat(n.sub, pos, next)
+	case jsAlternate:
+		for _, sub := range n.sub {
+			if m.match(sub, pos, next) {
+				return true
+			}
+		}
+
+		return false
+	case jsRepeat:
+		return m.repeat(n, 0, pos, next)
+	case jsLookahead:
+		found := m.match(n.sub[0], pos, func(int) bool { return true })
+
+		return found != n.negated && next(pos)
+	case jsLookbehind:
+		found := false
+		for start := pos; start >= 0 && !found; start-- {
+			found = m.match(n.sub[0], start, func(end int) bool { return end == pos })
+		}
+
+		return found != n.negated && next(pos)
+	case jsBeginText:
+		return pos == 0 && next(pos)
+	case jsEndText:
+		return pos == len(m.input) && next(pos)
+	case jsWordBoundary, jsNoWordBoundary:
+		before := pos > 0 && jsWordChar(m.input[pos-1])
+		after := pos < len(m.input) && jsWordChar(m.input[pos])
+
+		return (before != after) == (n.op == jsWordBoundary) && next(pos)
+	}
+
+	return false
+}
+
+func (m *jsMatcher) concat(nodes []*jsNode, pos int, next func(end int) bool) bool {
+	if len(nodes) == 0 {
+		return next(pos)
+	}
+
+	return m.match(nodes[0], pos, func(end int) bool {
+		return m.concat(nodes[1:], end, next)
+	})
+}
+
+// repeat follows RepeatMatcher of the spec, an iteration past the minimum that matches the empty string fails
+func (m *jsMatcher) repeat(n *jsNode, count, pos int, next func(end int) bool) bool {
+	more := func() bool {
+		if n.max != -1 && count >= n.max {
+			return false
+		}
+
+		return m.match(n.sub[0], pos, func(end int) bool {
+			if end == pos && count >= n.min {
+				return false
+			}
+
+			return m.repeat(n, count+1, end, next)
+		})
+	}
+	done := func() bool {
+		return count >= n.min && next(pos)
+	}
+
+	if n.lazy {
+		return done() || more()
+	}
+
+	return more() || done()
+}
+
+// jsWordChar is \w of a RegExp without the i flag
+func jsWordChar(r rune) bool {
+	return r == '_' || '0' <= r && r <= '9' || 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z'
+}
+
+func jsDigit(r rune) bool {
+	return '0' <= r && r <= '9'
+}
+
+// jsSpace is \s of a RegExp: WhiteSpace and LineTerminator of the spec
+func jsSpace(r rune) bool {
+	switch r {
+	case '\t', '\n', '\v', '\f', '\r', ' ', 0xa0, 0x1680, 0x2028, 0x2029, 0x202f, 0x205f, 0x3000, 0xfeff:
+		return true
+	}
+
+	return 0x2000 <= r && r <= 0x200a
+}
+
+// jsLineTerminator is what . doesn't match without the s flag
+func jsLineTerminator(r rune) bool {
+	return r == '\n' || r == '\r' || r == 0x2028 || r == 0x2029
+}
+
+func jsNot(class func(r rune) bool) func(r rune) bool {
+	return func(r rune) bool { return !class(r) }
+}
+
+func jsRune(c rune) func(r rune) bool {
+	return func(r rune) bool { return r == c }
+}
+
+type jsParser struct {
+	src []rune
+	pos int
+}
+
+func (p *jsParser) fail(reason string) error {
+	return fmt.Errorf("JS RegExp %q at %d: %s", string(p.src), p.pos, reason)
+}
+
+func (p *jsParser) peek(s string) bool {
+	return strings.HasPrefix(string(p.src[p.pos:]), s)
+}
+
+func (p *jsParser) alternate() (*jsNode, error) {
+	var subs []*jsNode
+	for {
+		sub, err := p.concat()
+		if err != nil {
+			return nil, err
+		}
+		subs = append(subs, sub)
+
+		if !p.peek("|") {
+			break
+		}
+		p.pos++
+	}
+
+	if len(subs) == 1 {
+		return subs[0], nil
+	}
+
+	return &jsNode{op: jsAlternate, sub: subs}, nil
+}
+
+func (p *jsParser) concat() (*jsNode, error) {
+	n := &jsNode{op: jsConcat}
+	for p.pos < len(p.src) && !p.peek("|") && !p.peek(")") {
+		term, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		n.sub = append(n.sub, term)
+	}
+
+	return n, nil
+}
+
+func (p *jsParser) term() (*jsNode, error) {
+	atom, quantifiable, err := p.atom()
+	if err != nil {
+		return nil, err
+	}
+
+	min, max, ok := p.quantifier()
+	if !ok {
+		return atom, nil
+	}
+	if !quantifiable {
+		return nil, p.fail("nothing to repeat")
+	}
+	if max != -1 && min > max {
+		return nil, p.fail("numbers out of order in quantifier")
+	}
+
+	n := &jsNode{op: jsRepeat, sub: []*jsNode{atom}, min: min, max: max}
+	if p.peek("?") {
+		n.lazy = true
+		p.pos++
+	}
+
+	return n, nil
+}
+
+// quantifier reads *, +, ?, {n}, {n,} or {n,m}, max is -1 when there is no bound
+func (p *jsParser) quantifier() (min, max int, ok bool) {
+	if p.pos >= len(p.src) {
+		return 0, 0, false
+	}
+
+	switch p.src[p.pos] {
+	case '*':
+		p.pos++
+		return 0, -1, true
+	case '+':
+		p.pos++
+		return 1, -1, true
+	case '?':
+		p.pos++
+		return 0, 1, true
+	case '{':
+		end := strings.IndexByte(string(p.src[p.pos:]), '}')
+		if end < 0 {
+			return 0, 0, false
+		}
+		body := string(p.src[p.pos:])[1:end]
+
+		lo, hi, bounded := strings.Cut(body, ",")
+		min, err := strconv.Atoi(lo)
+		if err != nil {
+			return 0, 0, false
+		}
+		max = min
+		if bounded {
+			max = -1
+			if hi != "" {
+				if max, err = strconv.Atoi(hi); err != nil {
+					return 0, 0, false
+				}
+			}
+		}
+
+		p.pos += len([]rune(body)) + 2
+		return min, max, true
+	}
+
+	return 0, 0, false
+}
+
+func (p *jsParser) atom() (n *jsNode, quantifiable bool, err error) {
+	switch c := p.src[p.pos]; c {
+	case '^':
+		p.pos++
+		return &jsNode{op: jsBeginText}, false, nil
+	case '$':
+		p.pos++
+		return &jsNode{op: jsEndText}, false, nil
+	case '.':
+		p.pos++
+		return &jsNode{op: jsChar, class: jsNot(jsLineTerminator)}, true, nil
+	case '(':
+		return p.group()
+	case '[':
+		class, err := p.class()
+		if err != nil {
+			return nil, false, err
+		}
+
+		return &jsNode{op: jsChar, class: class}, true, nil
+	case '\\':
+		switch {
+		case p.peek(`\b`):
+			p.pos += 2
+			return &jsNode{op: jsWordBoundary}, false, nil
+		case p.peek(`\B`):
+			p.pos += 2
+			return &jsNode{op: jsNoWordBoundary}, false, nil
+		}
+
+		class, _, _, err := p.escape(false)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return &jsNode{op: jsChar, class: class}, true, nil
+	case '*', '+', '?', '{', '}', ']', ')':
+		return nil, false, p.fail("unexpected " + string(c))
+	default:
+		p.pos++
+		return &jsNode{op: jsChar, class: jsRune(c)}, true, nil
+	}
+}
+
+func (p *jsParser) group() (n *jsNode, quantifiable bool, err error) {
+	n = &jsNode{op: jsConcat}
+	quantifiable = true
+
+	switch {
+	case p.peek("(?:"):
+		p.pos += 3
+	case p.peek("(?="), p.peek("(?!"):
+		n = &jsNode{op: jsLookahead, negated: p.src[p.pos+2] == '!'}
+		quantifiable = false
+		p.pos += 3
+	case p.peek("(?<="), p.peek("(?<!"):
+		n = &jsNode{op: jsLookbehind, negated: p.src[p.pos+3] == '!'}
+		quantifiable = false
+		p.pos += 4
+	case p.peek("(?<"):
+		end := strings.IndexByte(string(p.src[p.pos:]), '>')
+		if end < 0 || !jsName.MatchString(string(p.src[p.pos:])[3:end]) {
+			return nil, false, p.fail("bad group name")
+		}
+		p.pos += len([]rune(string(p.src[p.pos:])[:end])) + 1
+	case p.peek("(?"):
+		return nil, false, p.fail("invalid group")
+	default:
+		p.pos++
+	}
+
+	sub, err := p.alternate()
+	if err != nil {
+		return nil, false, err
+	}
+	if !p.peek(")") {
+		return nil, false, p.fail("unterminated group")
+	}
+	p.pos++
+
+	n.sub = []*jsNode{sub}
+
+	return n, quantifiable, nil
+}
+
+// escape reads an escape sequence and returns the class of the characters it matches,
+// single is true when it is one character that can bound a range of a class
+func (p *jsParser) escape(inClass bool) (class func(r rune) bool, r rune, single bool, err error) {
+	p.pos++
+	if p.pos >= len(p.src) {
+		return nil, 0, false, p.fail(`\ at the end of the pattern`)
+	}
+
+	c := p.src[p.pos]
+	p.pos++
+
+	switch c {
+	case 'd':
+		return jsDigit, 0, false, nil
+	case 'D':
+		return jsNot(jsDigit), 0, false, nil
+	case 'w':
+		return jsWordChar, 0, false, nil
+	case 'W':
+		return jsNot(jsWordChar), 0, false, nil
+	case 's':
+		return jsSpace, 0, false, nil
+	case 'S':
+		return jsNot(jsSpace), 0, false, nil
+	case 'p', 'P':
+		return nil, 0, false, p.fail("property escapes are not supported")
+	}
+
+	r, err = p.characterEscape(c, inClass)
+	if err != nil {
+		return nil, 0, false, err
+	}
+
+	return jsRune(r), r, true, nil
+}
+
+// characterEscape returns the character of an escape that is not a class, c follows the backslash
+func (p *jsParser) characterEscape(c rune, inClass bool) (rune, error) {
+	switch c {
+	case 'n':
+		return '\n', nil
+	case 'r':
+		return '\r', nil
+	case 't':
+		return '\t', nil
+	case 'f':
+		return '\f', nil
+	case 'v':
+		return '\v', nil
+	case 'b':
+		if inClass {
+			return '\b', nil
+		}
+	case '0':
+		if p.pos < len(p.src) && jsDigit(p.src[p.pos]) {
+			return 0, p.fail("octal escapes are not allowed with the v flag")
+		}
+		return 0, nil
+	case 'c':
+		if p.pos < len(p.src) && ('a' <= p.src[p.pos] && p.src[p.pos] <= 'z' || 'A' <= p.src[p.pos] && p.src[p.pos] <= 'Z') {
+			p.pos++
+			return p.src[p.pos-1] % 32, nil
+		}
+	case 'x':
+		return p.hexEscape(2)
+	case 'u':
+		if !p.peek("{") {
+			return p.hexEscape(4)
+		}
+
+		end := strings.IndexByte(string(p.src[p.pos:]), '}')
+		if end < 0 {
+			return 0, p.fail(`unterminated \u{`)
+		}
+
+		r, err := strconv.ParseUint(string(p.src[p.pos+1:p.pos+end]), 16, 32)
+		if err != nil || r > unicode.MaxRune {
+			return 0, p.fail(`bad \u{} escape`)
+		}
+		p.pos += end + 1
+
+		return rune(r), nil
+	}
+
+	// only syntax characters, / and, in a class, the reserved punctuators can be escaped with the v flag
+	if strings.ContainsRune(jsSyntaxChars, c) || inClass && strings.ContainsRune(jsClassChars, c) {
+		return c, nil
+	}
+	if '1' <= c && c <= '9' {
+		return 0, p.fail("backreferences are not supported")
+	}
+
+	return 0, p.fail(`invalid escape \` + string(c))
+}
+
+func (p *jsParser) hexEscape(digits int) (rune, error) {
+	if p.pos+digits > len(p.src) {
+		return 0, p.fail("incomplete hex escape")
+	}
+
+	r, err := strconv.ParseUint(string(p.src[p.pos:p.pos+digits]), 16, 32)
+	if err != nil {
+		return 0, p.fail("bad hex escape")
+	}
+	p.pos += digits
+
+	return rune(r), nil
+}
+
+// class reads a class of the v flag: a union of characters, ranges, escapes and nested classes,
+// or operands joined by && (intersection) or -- (subtraction)
+func (p *jsParser) class() (func(r rune) bool, error) {
+	p.pos++
+
+	negated := p.peek("^")
+	if negated {
+		p.pos++
+	}
+
+	var union []func(r rune) bool
+	var operator string
+	for !p.peek("]") {
+		if p.pos >= len(p.src) {
+			return nil, p.fail("unterminated class")
+		}
+
+		if p.peek("&&") || p.peek("--") {
+			op := string(p.src[p.pos : p.pos+2])
+			if len(union) != 1 || operator != "" && operator != op {
+				return nil, p.fail("mixed or missing operands of " + op)
+			}
+			operator = op
+			p.pos += 2
+
+			operand, _, _, err := p.classAtom()
+			if err != nil {
+				return nil, err
+			}
+
+			left := union[0]
+			if op == "&&" {
+				union[0] = func(r rune) bool { return left(r) && operand(r) }
+			} else {
+				union[0] = func(r rune) bool { return left(r) && !operand(r) }
+			}
+			continue
+		}
+		if operator != "" {
+			return nil, p.fail("a class with " + operator + " can't have a union")
+		}
+
+		atom, lo, single, err := p.classAtom()
+		if err != nil {
+			return nil, err
+		}
+
+		// with the v flag a - between two characters always makes a range
+		if single && p.peek("-") && !p.peek("--") {
+			p.pos++
+
+			_, hi, single, err := p.classAtom()
+			if err != nil {
+				return nil, err
+			}
+			if !single || hi < lo {
+				return nil, p.fail("bad range")
+			}
+
+			union = append(union, func(r rune) bool { return lo <= r && r <= hi })
+			continue
+		}
+
+		union = append(union, atom)
+	}
+	p.pos++
+
+	class := func(r rune) bool {
+		for _, member := range union {
+			if member(r) {
+				return true
+			}
+		}
+
+		return false
+	}
+	if negated {
+		return jsNot(class), nil
+	}
+
+	return class, nil
+}
+
+// classAtom reads a character, an escape or a nested class of a class
+func (p *jsParser) classAtom() (class func(r rune) bool, r rune, single bool, err error) {
+	if p.pos >= len(p.src) {
+		return nil, 0, false, p.fail("unterminated class")
+	}
+
+	c := p.src[p.pos]
+	switch {
+	case c == '[':
+		class, err := p.class()
+		return class, 0, false, err
+	case c == '\\':
+		return p.escape(true)
+	case strings.ContainsRune("()]{}/-|", c):
+		return nil, 0, false, p.fail("unescaped " + string(c) + " in a class")
+	case p.pos+1 < len(p.src) && p.src[p.pos+1] == c && strings.ContainsRune("&!#$%*+,.:;<=>?@^`~", c):
+		return nil, 0, false, p.fail("reserved double punctuator " + string([]rune{c, c}))
+	}
+	p.pos++
+
+	return jsRune(c), c, true, nil
+}
@@ -0,0 +1,129 @@
+package prop
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/hexops/vecty"
+)
+
+// ExternalLinkOptions are the target, the referrer policy and the extra link types of ExternalLink.
+// The target is _blank and the allowed URL schemes are http, https, mailto and tel unless they are changed
+// ex: NewExternalLinkOptions().ReferrerPolicy(ReferrerPolicyCaseStrictOrigin).UGC()
+type ExternalLinkOptions struct {
+	target  TargetCase
+	policy  ReferrerPolicyCase
+	tokens  []RelToken
+	schemes map[string]bool
+}
+
+// Target specifies where the link opens, every target but _self, _parent and _top is a new browsing context
+func (b *ExternalLinkOptions) Target(c TargetCase) *ExternalLinkOptions {
+	b.target = c
+
+	return b
+}
+
+// ReferrerPolicy sends the referrer the policy allows, noreferrer is left out of rel since it would override it
+func (b *ExternalLinkOptions) ReferrerPolicy(c ReferrerPolicyCase) *ExternalLinkOptions {
+	b.policy = c
+
+	return b
+}
+
+// UGC marks the link as user-generated content, such as a link in a comment
+func (b *ExternalLinkOptions) UGC() *ExternalLinkOptions {
+	b.tokens = append(b.tokens, RelTokenUGC)
+
+	return b
+}
+
+// Sponsored marks the link as an advertisement or a paid placement
+func (b *ExternalLinkOptions) Sponsored() *ExternalLinkOptions {
+	b.tokens = append(b.tokens, RelTokenSponsored)
+
+	return b
+}
+
+func (b *ExternalLinkOptions) Nofollow() *ExternalLinkOptions {
+	b.tokens = append(b.tokens, RelTokenNofollow)
+
+	return b
+}
+
+// AllowURLSchemes allows the schemes on top of http, https, mailto and tel.
+// Relative URLs are always allowed, javascript: and data: are refused unless they are listed here
+func (b *ExternalLinkOptions) AllowURLSchemes(schemes ...string) *ExternalLinkOptions {
+	for _, scheme := range schemes {
+		b.schemes[strings.ToLower(strings.TrimSuffix(scheme, ":"))] = true
+	}
+
+	return b
+}
+
+// newContext reports whether the target opens a new browsing context, rel then needs noopener
+func (b *ExternalLinkOptions) newContext() bool {
+	switch strings.ToLower(b.target.String()) {
+	case "", string(TargetCaseSelf), string(TargetCaseParent), string(TargetCaseTop):
+		return false
+	}
+
+	return true
+}
+
+func (b *ExternalLinkOptions) buildExternalLink(href URL) ([]vecty.Applyer, error) {
+	bad := func(reason string) error {
+		return &ValueError{Attr: "href", Value: string(href), Reason: reason}
+	}
+
+	u, err := url.Parse(strings.TrimSpace(string(href)))
+	if err != nil {
+		return nil, bad(err.Error())
+	}
+	if scheme := urlScheme(string(href)); scheme != "" && !b.schemes[scheme] {
+		return nil, bad("the " + scheme + ": scheme is not allowed, see ExternalLinkOptions.AllowURLSchemes")
+	}
+
+	applyers := []vecty.Applyer{applyAttr("href", u.String())}
+
+	if b.target != "" {
+		if err := b.target.check(); err != nil {
+			return nil, err
+		}
+
+		applyers = append(applyers, applyAttr("target", b.target.String()))
+	}
+
+	if b.policy != "" {
+		if err := b.policy.check(); err != nil {
+			return nil, err
+		}
+
+		applyers = append(applyers, applyAttr("referrerpolicy", b.policy.String()))
+	}
+
+	var tokens []RelToken
+	if b.newContext() {
+		tokens = append(tokens, RelTokenNoOpener)
+		if b.policy == "" {
+			tokens = append(tokens, RelTokenNoReferrer)
+		}
+	}
+	if tokens = append(tokens, b.tokens...); len(tokens) != 0 {
+		rel, err := RelE("a", tokens...)
+		if err != nil {
+			return nil, err
+		}
+
+		applyers = append(applyers, rel)
+	}
+
+	return applyers, nil
+}
+
+func NewExternalLinkOptions() *ExternalLinkOptions {
+	return &ExternalLinkOptions{
+		target:  TargetCaseBlank,
+		schemes: defaultURLSchemes(),
+	}
+}
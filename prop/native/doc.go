@@ -0,0 +1,17 @@
+// Package native lets vecty be loaded outside of a browser, so that prop.RenderHTML, prop.FromVecty
+// and the a11y checks work on a server, in a command line tool and in plain go test.
+// Import it for its side effect in the main package or in the tests:
+//
+//	import _ "github.com/Hand-of-Doom/Vecty-Props/prop/native"
+//
+// vecty panics during its initialization unless it runs in a browser or in its own tests.
+// This package sets the flag vecty uses for its own tests, vecty then skips the browser check.
+// The flag is static data set by the linker, not by an init function,
+// so it doesn't matter in which order the packages are initialized.
+//
+// The flag changes more than the check: vecty.RenderBody returns instead of blocking forever,
+// so a program that imports this package must not rely on RenderBody to keep it running.
+// The flag is reached through go:linkname, which only works with the vecty version go.mod pins,
+// the tests of this package fail when vecty renames it.
+// It does nothing under GOOS=js
+package native
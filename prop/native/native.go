@@ -0,0 +1,12 @@
+//go:build !js
+
+package native
+
+import (
+	_ "unsafe"
+)
+
+// vectyIsTest is vecty's test flag, the initializer is a constant so the linker sets it before any package is initialized
+//
+//go:linkname vectyIsTest github.com/hexops/vecty.isTest
+var vectyIsTest = true
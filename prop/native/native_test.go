@@ -0,0 +1,34 @@
+//go:build !js
+
+package native
+
+import (
+	"runtime/debug"
+	"testing"
+
+	"github.com/hexops/vecty"
+)
+
+// vectyVersion is the version of vecty whose isTest flag vectyIsTest links to
+const vectyVersion = "v0.6.0"
+
+// TestVectyIsTest runs only when vecty's init didn't panic, which it does outside a browser
+// when the linked flag is no longer the one vecty reads
+func TestVectyIsTest(t *testing.T) {
+	if !vectyIsTest {
+		t.Fatal("vectyIsTest is not set")
+	}
+	if h := vecty.Tag("div"); h == nil {
+		t.Fatal("vecty.Tag = nil")
+	}
+
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		t.Skip("no build info")
+	}
+	for _, dep := range info.Deps {
+		if dep.Path == "github.com/hexops/vecty" && dep.Version != vectyVersion {
+			t.Errorf("vecty %s is used, vectyIsTest links to the flag of %s", dep.Version, vectyVersion)
+		}
+	}
+}
@@ -0,0 +1,393 @@
+package prop
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"strings"
+)
+
+var (
+	escapableRawTextElements = map[string]bool{
+		"textarea": true,
+		"title":    true,
+	}
+
+	// impliedEndTags lists the open elements that are closed by a start tag
+	// ex: <li>one<li>two
+	impliedEndTags = map[string]map[string]bool{
+		"li":       {"li": true},
+		"dt":       {"dt": true, "dd": true},
+		"dd":       {"dt": true, "dd": true},
+		"option":   {"option": true},
+		"optgroup": {"optgroup": true, "option": true},
+		"tr":       {"tr": true, "td": true, "th": true},
+		"td":       {"td": true, "th": true},
+		"th":       {"td": true, "th": true},
+		"thead":    {"thead": true, "tbody": true, "tfoot": true, "tr": true, "td": true, "th": true},
+		"tbody":    {"thead": true, "tbody": true, "tfoot": true, "tr": true, "td": true, "th": true},
+		"tfoot":    {"thead": true, "tbody": true, "tfoot": true, "tr": true, "td": true, "th": true},
+	}
+
+	// paragraphClosers are the start tags that close an open <p>
+	paragraphClosers = map[string]bool{
+		"address": true, "article": true, "aside": true, "blockquote": true, "details": true,
+		"div": true, "dl": true, "fieldset": true, "figcaption": true, "figure": true,
+		"footer": true, "form": true, "h1": true, "h2": true, "h3": true, "h4": true,
+		"h5": true, "h6": true, "header": true, "hr": true, "main": true, "menu": true,
+		"nav": true, "ol": true, "p": true, "pre": true, "section": true, "table": true, "ul": true,
+	}
+)
+
+// ParseError means that the markup can't be turned into a FakeDOM tree
+type ParseError struct {
+	Offset int
+	Reason string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("html: offset %d: %s", e.Offset, e.Reason)
+}
+
+// ParseFakeDOM builds a FakeDOM tree from the markup, the result is a *Document.
+// Elements are nested as written, with the implied end tags of <p>, <li>, <option> and table parts,
+// it is not the whole tree construction algorithm of a browser
+func ParseFakeDOM(r io.Reader) (FakeDOM, error) {
+	src, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &fakeDOMParser{
+		src: string(src),
+		doc: &Document{},
+	}
+	if err := p.parse(); err != nil {
+		return nil, err
+	}
+
+	return p.doc, nil
+}
+
+type fakeDOMParser struct {
+	src   string
+	pos   int
+	doc   *Document
+	stack []*Node
+}
+
+func (p *fakeDOMParser) parse() error {
+	for p.pos < len(p.src) {
+		i := strings.IndexByte(p.src[p.pos:], '<')
+		if i < 0 {
+			p.text(p.src[p.pos:], true)
+			break
+		}
+		if i > 0 {
+			p.text(p.src[p.pos:p.pos+i], true)
+			p.pos += i
+		}
+
+		rest := p.src[p.pos:]
+
+		var err error
+		switch {
+		case strings.HasPrefix(rest, "<!--"):
+			err = p.comment()
+		case strings.HasPrefix(rest, "<!"), strings.HasPrefix(rest, "<?"):
+			err = p.declaration()
+		case strings.HasPrefix(rest, "</") && len(rest) > 2 && isASCIILetter(rest[2]):
+			err = p.endTag()
+		case len(rest) > 1 && isASCIILetter(rest[1]):
+			err = p.startTag()
+		default:
+			p.text("<", false)
+			p.pos++
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *fakeDOMParser) fail(reason string) error {
+	return &ParseError{
+		Offset: p.pos,
+		Reason: reason,
+	}
+}
+
+func (p *fakeDOMParser) append(node FakeDOM) {
+	if len(p.stack) == 0 {
+		p.doc.nodes = append(p.doc.nodes, node)
+		return
+	}
+
+	top := p.stack[len(p.stack)-1]
+	top.nodes = append(top.nodes, node)
+}
+
+func (p *fakeDOMParser) text(text string, escaped bool) {
+	if escaped {
+		text = html.UnescapeString(text)
+	}
+
+	nodes := p.doc.nodes
+	if len(p.stack) != 0 {
+		nodes = p.stack[len(p.stack)-1].nodes
+	}
+
+	if len(nodes) != 0 {
+		if last, ok := nodes[len(nodes)-1].(*TextNode); ok {
+			last.text += text
+			return
+		}
+	}
+
+	p.append(NewTextNode(text))
+}
+
+// comment parses a comment the way the HTML tokenizer does, it ends at --> or --!>,
+// and <!--> and <!---> are empty comments
+func (p *fakeDOMParser) comment() error {
+	start := p.pos + len("<!--")
+	for _, abrupt := range []string{">", "->"} {
+		if strings.HasPrefix(p.src[start:], abrupt) {
+			p.append(NewCommentNode(""))
+			p.pos = start + len(abrupt)
+			return nil
+		}
+	}
+
+	end, closer := strings.Index(p.src[start:], "-->"), "-->"
+	if bang := strings.Index(p.src[start:], "--!>"); bang >= 0 && (end < 0 || bang < end) {
+		end, closer = bang, "--!>"
+	}
+	if end < 0 {
+		return p.fail("unterminated comment")
+	}
+
+	p.append(NewCommentNode(p.src[start : start+end]))
+	p.pos = start + end + len(closer)
+
+	return nil
+}
+
+// declaration parses a doctype, anything else that starts with <! or <? is kept as a comment
+func (p *fakeDOMParser) declaration() error {
+	end := strings.IndexByte(p.src[p.pos:], '>')
+	if end < 0 {
+		return p.fail("unterminated declaration")
+	}
+
+	content := p.src[p.pos+2 : p.pos+end]
+	if strings.HasPrefix(strings.ToLower(content), "doctype") {
+		p.doc.doctype = true
+	} else {
+		p.append(NewCommentNode(content))
+	}
+	p.pos += end + 1
+
+	return nil
+}
+
+func (p *fakeDOMParser) endTag() error {
+	end := strings.IndexByte(p.src[p.pos:], '>')
+	if end < 0 {
+		return p.fail("unterminated end tag")
+	}
+
+	name := strings.ToLower(p.src[p.pos+2 : p.pos+2+tagNameLength(p.src[p.pos+2:])])
+	p.pos += end + 1
+
+	for i := len(p.stack) - 1; i >= 0; i-- {
+		if p.stack[i].name == name {
+			p.stack = p.stack[:i]
+			break
+		}
+	}
+
+	return nil
+}
+
+func (p *fakeDOMParser) startTag() error {
+	start := p.pos
+	p.pos++
+
+	length := tagNameLength(p.src[p.pos:])
+	node := &Node{
+		name: strings.ToLower(p.src[p.pos : p.pos+length]),
+	}
+	p.pos += length
+
+	selfClosing := false
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.src) {
+			p.pos = start
+			return p.fail("unterminated start tag <" + node.name + ">")
+		}
+
+		if p.src[p.pos] == '>' {
+			p.pos++
+			break
+		}
+		if strings.HasPrefix(p.src[p.pos:], "/>") {
+			selfClosing = true
+			p.pos += 2
+			break
+		}
+		if p.src[p.pos] == '/' {
+			p.pos++
+			continue
+		}
+
+		attr, err := p.attr()
+		if err != nil {
+			return err
+		}
+		if _, ok := node.Attr(attr.key); !ok {
+			node.attrs = append(node.attrs, attr)
+		}
+	}
+
+	p.open(node)
+
+	if voidElements[node.name] {
+		return nil
+	}
+	if selfClosing {
+		p.stack = p.stack[:len(p.stack)-1]
+		return nil
+	}
+
+	if rawTextElements[node.name] || escapableRawTextElements[node.name] {
+		p.rawText(node)
+	}
+
+	return nil
+}
+
+// open puts the node into the tree, closing the elements it implies the end of
+func (p *fakeDOMParser) open(node *Node) {
+	for len(p.stack) != 0 {
+		top := p.stack[len(p.stack)-1]
+		if !impliedEndTags[node.name][top.name] && !(paragraphClosers[node.name] && top.name == "p") {
+			break
+		}
+
+		p.stack = p.stack[:len(p.stack)-1]
+	}
+
+	p.append(node)
+
+	if !voidElements[node.name] {
+		p.stack = append(p.stack, node)
+	}
+}
+
+// rawText reads everything up to the end tag of the node as a text
+func (p *fakeDOMParser) rawText(node *Node) {
+	end := len(p.src)
+	if node.name != "plaintext" {
+		end = p.pos + rawTextEnd(p.src[p.pos:], node.name)
+	}
+
+	if end > p.pos {
+		p.text(p.src[p.pos:end], escapableRawTextElements[node.name])
+	}
+	p.pos = end
+}
+
+func (p *fakeDOMParser) attr() (*Attr, error) {
+	start := p.pos
+
+	// the first character of a name can be anything, even =
+	p.pos++
+	for p.pos < len(p.src) && !isSpace(p.src[p.pos]) && !strings.ContainsRune("/>=", rune(p.src[p.pos])) {
+		p.pos++
+	}
+
+	key := strings.ToLower(p.src[start:p.pos])
+	if !attrNamePattern.MatchString(key) {
+		p.pos = start
+		return nil, p.fail(fmt.Sprintf("%q is not a valid attribute name", key))
+	}
+
+	p.skipSpace()
+	if p.pos >= len(p.src) || p.src[p.pos] != '=' {
+		return &Attr{key: key, boolean: true}, nil
+	}
+	p.pos++
+	p.skipSpace()
+
+	if p.pos >= len(p.src) {
+		return nil, p.fail("missing value of attribute " + key)
+	}
+
+	var value string
+	switch quote := p.src[p.pos]; quote {
+	case '"', '\'':
+		end := strings.IndexByte(p.src[p.pos+1:], quote)
+		if end < 0 {
+			return nil, p.fail("unterminated value of attribute " + key)
+		}
+
+		value = p.src[p.pos+1 : p.pos+1+end]
+		p.pos += end + 2
+	default:
+		valueStart := p.pos
+		for p.pos < len(p.src) && !isSpace(p.src[p.pos]) && p.src[p.pos] != '>' {
+			p.pos++
+		}
+
+		value = p.src[valueStart:p.pos]
+	}
+
+	return &Attr{key: key, value: html.UnescapeString(value)}, nil
+}
+
+func (p *fakeDOMParser) skipSpace() {
+	for p.pos < len(p.src) && isSpace(p.src[p.pos]) {
+		p.pos++
+	}
+}
+
+// rawTextEnd returns the position of the end tag of the element in the text or the length of the text
+func rawTextEnd(text, name string) int {
+	lower := strings.ToLower(text)
+
+	for offset := 0; ; {
+		i := strings.Index(lower[offset:], "</"+name)
+		if i < 0 {
+			return len(text)
+		}
+
+		end := offset + i + len("</"+name)
+		if end == len(text) || isSpace(text[end]) || text[end] == '/' || text[end] == '>' {
+			return offset + i
+		}
+		offset = end
+	}
+}
+
+func tagNameLength(src string) int {
+	for i := 0; i < len(src); i++ {
+		if isSpace(src[i]) || src[i] == '/' || src[i] == '>' {
+			return i
+		}
+	}
+
+	return len(src)
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
+
+func isASCIILetter(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
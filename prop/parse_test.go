@@ -0,0 +1,75 @@
+package prop
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseFakeDOM(t *testing.T) {
+	tests := []struct {
+		name string
+		html string
+		want string
+	}{
+		{name: "round trip", html: `<div class="a" hidden><p>a &amp; b</p><br><!-- c --></div>`},
+		{name: "doctype", html: "<!DOCTYPE html><html><body></body></html>"},
+		{name: "raw text", html: "<script>if (a < b) {}</script><style>a > b {}</style>"},
+		{name: "escaped attribute", html: `<a title="&quot;a&quot; &amp; b">a</a>`},
+		{name: "unquoted attribute", html: "<a href=/a title='b'>a</a>", want: `<a href="/a" title="b">a</a>`},
+		{name: "upper case", html: "<DIV ID=a></DIV>", want: `<div id="a"></div>`},
+		{name: "repeated attribute", html: `<b id="a" id="b"></b>`, want: `<b id="a"></b>`},
+		{name: "self-closing", html: "<div/><br/>", want: "<div></div><br>"},
+		{name: "implied end tags", html: "<ul><li>a<li>b</ul><p>c<div>d</div>", want: "<ul><li>a</li><li>b</li></ul><p>c</p><div>d</div>"},
+		{name: "comment ended by --!>", html: "<p><!-- a --!> b --></p>", want: "<p><!-- a --> b --&gt;</p>"},
+		{name: "empty comment", html: "<!--><p>a</p>", want: "<!----><p>a</p>"},
+		{name: "empty comment with a dash", html: "<!---><p>a</p>", want: "<!----><p>a</p>"},
+		{name: "comment with dashes", html: "<!-- a - b -- c -->"},
+		{name: "table", html: "<table><tr><td>a<td>b<tr><td>c</table>", want: "<table><tr><td>a</td><td>b</td></tr><tr><td>c</td></tr></table>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.want == "" {
+				tt.want = tt.html
+			}
+
+			tree, err := ParseFakeDOM(strings.NewReader(tt.html))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := tree.buildTree()
+			if got != tt.want {
+				t.Errorf("ParseFakeDOM = %s, want %s", got, tt.want)
+			}
+
+			// the markup of a parsed tree parses to the same tree
+			again, err := ParseFakeDOM(strings.NewReader(got))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if again.buildTree() != got {
+				t.Errorf("the markup of %s doesn't round trip, got %s", got, again.buildTree())
+			}
+		})
+	}
+}
+
+func TestParseFakeDOMErrors(t *testing.T) {
+	for _, html := range []string{
+		"<div",
+		`<a href="/a>a</a>`,
+		"<a href=",
+		`<a "=b>a</a>`,
+		"<!-- a",
+		"<div></div",
+	} {
+		_, err := ParseFakeDOM(strings.NewReader(html))
+
+		var parseErr *ParseError
+		if !errors.As(err, &parseErr) {
+			t.Errorf("ParseFakeDOM(%q) error = %v, want a ParseError", html, err)
+		}
+	}
+}
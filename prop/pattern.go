@@ -0,0 +1,282 @@
+package prop
+
+import (
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+	"unicode"
+)
+
+// PatternError reports a Go regular expression that has no equivalent in the pattern attribute
+type PatternError struct {
+	Pattern string
+	Reason  string
+}
+
+func (e *PatternError) Error() string {
+	return fmt.Sprintf("pattern %q can't be translated to a browser RegExp: %s", e.Pattern, e.Reason)
+}
+
+// jsName is the group name allowed by JS, Go also allows names starting with a digit
+var jsName = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// TranslatePattern rewrites the Go regular expression into the syntax of a JS RegExp with the v flag,
+// the way browsers compile the pattern attribute. Flags such as (?i) and (?s) are expanded into character classes
+// ex: (?i)ab => [Aa][Bb], (?P<year>\d{4}) => (?<year>[0-9]{4}), . => [^\n]
+func TranslatePattern(value *regexp.Regexp) (string, error) {
+	re, err := syntax.Parse(value.String(), syntax.Perl)
+	if err != nil {
+		return "", &PatternError{Pattern: value.String(), Reason: err.Error()}
+	}
+
+	var b strings.Builder
+	if err := translateRegexp(&b, re); err != nil {
+		return "", &PatternError{Pattern: value.String(), Reason: err.Error()}
+	}
+
+	return b.String(), nil
+}
+
+func translateRegexp(b *strings.Builder, re *syntax.Regexp) error {
+	switch re.Op {
+	case syntax.OpNoMatch:
+		b.WriteString("[]")
+	case syntax.OpEmptyMatch:
+		b.WriteString("(?:)")
+	case syntax.OpLiteral:
+		for _, r := range re.Rune {
+			if re.Flags&syntax.FoldCase != 0 {
+				writeFoldedRune(b, r)
+			} else {
+				b.WriteString(escapeJSRune(r, false))
+			}
+		}
+	case syntax.OpCharClass:
+		writeClass(b, re.Rune)
+	case syntax.OpAnyCharNotNL:
+		b.WriteString(`[^\n]`)
+	case syntax.OpAnyChar:
+		b.WriteString(`[\s\S]`)
+	case syntax.OpBeginLine:
+		// JS has no multiline flag in the pattern attribute, a lookbehind does the same
+		b.WriteString(`(?<![^\n])`)
+	case syntax.OpEndLine:
+		b.WriteString(`(?![^\n])`)
+	case syntax.OpBeginText:
+		b.WriteString("^")
+	case syntax.OpEndText:
+		b.WriteString("$")
+	case syntax.OpWordBoundary:
+		b.WriteString(`\b`)
+	case syntax.OpNoWordBoundary:
+		b.WriteString(`\B`)
+	case syntax.OpCapture:
+		if re.Name != "" {
+			if !jsName.MatchString(re.Name) {
+				return fmt.Errorf("the group name %s is not a JS identifier", re.Name)
+			}
+			b.WriteString("(?<" + re.Name + ">")
+		} else {
+			b.WriteString("(")
+		}
+		if err := translateRegexp(b, re.Sub[0]); err != nil {
+			return err
+		}
+		b.WriteString(")")
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat:
+		if err := translateAtom(b, re.Sub[0]); err != nil {
+			return err
+		}
+
+		switch re.Op {
+		case syntax.OpStar:
+			b.WriteString("*")
+		case syntax.OpPlus:
+			b.WriteString("+")
+		case syntax.OpQuest:
+			b.WriteString("?")
+		default:
+			switch {
+			case re.Max == -1:
+				fmt.Fprintf(b, "{%d,}", re.Min)
+			case re.Min == re.Max:
+				fmt.Fprintf(b, "{%d}", re.Min)
+			default:
+				fmt.Fprintf(b, "{%d,%d}", re.Min, re.Max)
+			}
+		}
+
+		if re.Flags&syntax.NonGreedy != 0 {
+			b.WriteString("?")
+		}
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			if sub.Op == syntax.OpAlternate {
+				if err := translateGroup(b, sub); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if err := translateRegexp(b, sub); err != nil {
+				return err
+			}
+		}
+	case syntax.OpAlternate:
+		for i, sub := range re.Sub {
+			if i != 0 {
+				b.WriteString("|")
+			}
+
+			if err := translateRegexp(b, sub); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported operator %v", re.Op)
+	}
+
+	return nil
+}
+
+// translateAtom writes the operand of a quantifier, it is grouped unless it is a single character or a group
+func translateAtom(b *strings.Builder, re *syntax.Regexp) error {
+	switch {
+	case re.Op == syntax.OpLiteral && len(re.Rune) == 1,
+		re.Op == syntax.OpCharClass, re.Op == syntax.OpAnyChar, re.Op == syntax.OpAnyCharNotNL, re.Op == syntax.OpCapture:
+		return translateRegexp(b, re)
+	}
+
+	return translateGroup(b, re)
+}
+
+func translateGroup(b *strings.Builder, re *syntax.Regexp) error {
+	b.WriteString("(?:")
+	if err := translateRegexp(b, re); err != nil {
+		return err
+	}
+	b.WriteString(")")
+
+	return nil
+}
+
+// writeFoldedRune writes the rune and the runes it folds to as a class, the same as (?i) in Go
+func writeFoldedRune(b *strings.Builder, r rune) {
+	folds := []rune{r}
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		folds = append(folds, f)
+	}
+
+	if len(folds) == 1 {
+		b.WriteString(escapeJSRune(r, false))
+		return
+	}
+
+	b.WriteString("[")
+	for _, f := range folds {
+		b.WriteString(escapeJSRune(f, true))
+	}
+	b.WriteString("]")
+}
+
+// writeClass writes the ranges of the class, the parser has already applied negation and case folding
+func writeClass(b *strings.Builder, ranges []rune) {
+	if len(ranges) == 2 && ranges[0] == 0 && ranges[1] == unicode.MaxRune {
+		b.WriteString(`[\s\S]`)
+		return
+	}
+
+	b.WriteString("[")
+	for i := 0; i < len(ranges); i += 2 {
+		lo, hi := ranges[i], ranges[i+1]
+
+		b.WriteString(escapeJSRune(lo, true))
+		if hi != lo {
+			if hi > lo+1 {
+				b.WriteString("-")
+			}
+			b.WriteString(escapeJSRune(hi, true))
+		}
+	}
+	b.WriteString("]")
+}
+
+const (
+	// jsSyntaxChars are escaped everywhere
+	jsSyntaxChars = `^$\.*+?()[]{}|/`
+	// jsClassChars are also escaped in a class of the v flag, where doubled punctuators are reserved
+	jsClassChars = "-&!#%,:;<=>@`~"
+)
+
+func escapeJSRune(r rune, inClass bool) string {
+	switch {
+	case strings.ContainsRune(jsSyntaxChars, r), inClass && strings.ContainsRune(jsClassChars, r):
+		return `\` + string(r)
+	case r == '\n':
+		return `\n`
+	case r == '\r':
+		return `\r`
+	case r == '\t':
+		return `\t`
+	case r == '\f':
+		return `\f`
+	case r == '\v':
+		return `\v`
+	case r < ' ' || r == 0x7f || !unicode.IsPrint(r) && r != ' ':
+		return fmt.Sprintf(`\u{%X}`, r)
+	}
+
+	return string(r)
+}
+
+// PatternDiff is a sample string that the Go regexp and the pattern attribute made by TranslatePattern treat differently
+type PatternDiff struct {
+	Sample string
+	// Go is the result of MatchString, it finds a match anywhere in the sample
+	Go bool
+	// JS is whether a browser accepts the sample: the translation is run under the rules of a JS RegExp with the v flag,
+	// wrapped in ^(?: and )$ as browsers wrap the pattern attribute
+	JS bool
+}
+
+// DiffPattern returns the samples that the Go regexp and its translation for the pattern attribute don't agree on.
+// The translation is matched by an emulation of the JS engine: the whole value must match, \d, \w, \s and \b are
+// the JS classes, there is no case folding other than the classes written by TranslatePattern and classes may use
+// the set syntax of the v flag
+// ex: DiffPattern(regexp.MustCompile(`[0-9]+`), "12", "a12") => [{a12 true false}]
+func DiffPattern(value *regexp.Regexp, samples ...string) ([]PatternDiff, error) {
+	translated, err := TranslatePattern(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return diffPattern(value, translated, samples)
+}
+
+// diffPattern compares the Go regexp with the source of a JS RegExp
+func diffPattern(value *regexp.Regexp, translated string, samples []string) ([]PatternDiff, error) {
+	js, err := compileJSPattern(`^(?:` + translated + `)$`)
+	if err != nil {
+		return nil, &PatternError{Pattern: value.String(), Reason: err.Error()}
+	}
+
+	var diffs []PatternDiff
+	for _, sample := range samples {
+		matched, err := js.matchWhole(sample)
+		if err != nil {
+			return nil, &PatternError{Pattern: value.String(), Reason: fmt.Sprintf("%s: %q", err, sample)}
+		}
+
+		diff := PatternDiff{
+			Sample: sample,
+			Go:     value.MatchString(sample),
+			JS:     matched,
+		}
+		if diff.Go != diff.JS {
+			diffs = append(diffs, diff)
+		}
+	}
+
+	return diffs, nil
+}
@@ -0,0 +1,151 @@
+package prop
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestTranslatePattern(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+		err  bool
+	}{
+		{expr: `[0-9]+`, want: `[0-9]+`},
+		{expr: `(?i)ab`, want: `[Aa][Bb]`},
+		{expr: `(?P<year>\d{4})`, want: `(?<year>[0-9]{4})`},
+		{expr: `a.b`, want: `a[^\n]b`},
+		{expr: `(?s).`, want: `[\s\S]`},
+		{expr: `a|b`, want: `[ab]`},
+		{expr: `x(?:ab|cd)`, want: `x(?:ab|cd)`},
+		{expr: `[a-]`, want: `[\-a]`},
+		{expr: `(?P<1st>a)`, err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := TranslatePattern(regexp.MustCompile(tt.expr))
+			if (err != nil) != tt.err {
+				t.Fatalf("TranslatePattern error = %v, want error %v", err, tt.err)
+			}
+			if got != tt.want {
+				t.Errorf("TranslatePattern = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffPattern(t *testing.T) {
+	tests := []struct {
+		expr    string
+		samples []string
+		want    []PatternDiff
+	}{
+		{expr: `[0-9]+`, samples: []string{"12", "a12", ""}, want: []PatternDiff{{Sample: "a12", Go: true}}},
+		{expr: `a|bc`, samples: []string{"a", "bc", "ac"}, want: []PatternDiff{{Sample: "ac", Go: true}}},
+		{expr: `^(?i)k$`, samples: []string{"k", "K", "\u212a", "x"}},
+		{expr: `^\s$`, samples: []string{" ", "\t", "\v", "\u00a0"}},
+		{expr: `^a.b$`, samples: []string{"a\rb", "a\nb", "a\u2028b"}},
+		{expr: `^(?s)a.b$`, samples: []string{"a\nb"}},
+		{expr: `(?m)^a$`, samples: []string{"a", "b\na"}, want: []PatternDiff{{Sample: "b\na", Go: true}}},
+		{expr: `^\bé\b$`, samples: []string{"é"}},
+		{expr: `^[\pL-]+$`, samples: []string{"é-a", "1"}},
+		{expr: `^(?:ab)*?$`, samples: []string{"", "abab", "aba"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			diffs, err := DiffPattern(regexp.MustCompile(tt.expr), tt.samples...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(diffs, tt.want) {
+				t.Errorf("DiffPattern = %+v, want %+v", diffs, tt.want)
+			}
+		})
+	}
+
+	if _, err := DiffPattern(regexp.MustCompile(`(?P<1st>a)`)); err == nil {
+		t.Error("DiffPattern takes a pattern that can't be translated")
+	}
+	if _, err := DiffPattern(regexp.MustCompile(`^(a*)*b$`), strings.Repeat("a", 30)); err == nil {
+		t.Error("DiffPattern runs a sample that needs exponential backtracking")
+	}
+}
+
+// TestDiffPatternSemantics checks the emulation of the JS engine on translations that don't mean what the Go regexp does
+func TestDiffPatternSemantics(t *testing.T) {
+	tests := []struct {
+		name       string
+		expr       string
+		translated string
+		samples    []string
+		want       []PatternDiff
+	}{
+		{
+			name: "JS \\s has more spaces", expr: `^\s$`, translated: `\s`,
+			samples: []string{" ", "\v", "\u00a0", "\ufeff"},
+			want:    []PatternDiff{{Sample: "\v", JS: true}, {Sample: "\u00a0", JS: true}, {Sample: "\ufeff", JS: true}},
+		},
+		{
+			name: "JS . doesn't match line terminators", expr: `^a.b$`, translated: `a.b`,
+			samples: []string{"axb", "a\rb", "a\u2029b"},
+			want:    []PatternDiff{{Sample: "a\rb", Go: true}, {Sample: "a\u2029b", Go: true}},
+		},
+		{
+			name: "case folding beyond ASCII", expr: `^(?i)k$`, translated: `[Kk]`,
+			samples: []string{"k", "\u212a"},
+			want:    []PatternDiff{{Sample: "\u212a", Go: true}},
+		},
+		{
+			name: "Unicode digits", expr: `^\pN$`, translated: `\d`,
+			samples: []string{"1", "\u0663"},
+			want:    []PatternDiff{{Sample: "\u0663", Go: true}},
+		},
+		{
+			name: "set subtraction", expr: `^[a-z]$`, translated: `[a-z--[aeiou]]`,
+			samples: []string{"b", "e"},
+			want:    []PatternDiff{{Sample: "e", Go: true}},
+		},
+		{
+			name: "set intersection", expr: `^[0-9]$`, translated: `[\w&&\d]`,
+			samples: []string{"5", "a", "_"},
+		},
+		{
+			name: "word boundary", expr: `^a\b.$`, translated: `a\b[\s\S]`,
+			samples: []string{"a ", "ab", "a-"},
+		},
+		{
+			name: "lookbehind", expr: `^(?m)^b$`, translated: `(?<![^\n])b`,
+			samples: []string{"b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diffs, err := diffPattern(regexp.MustCompile(tt.expr), tt.translated, tt.samples)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(diffs, tt.want) {
+				t.Errorf("diffPattern = %+v, want %+v", diffs, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompileJSPattern(t *testing.T) {
+	for _, source := range []string{`a{2,1}`, `(?<1a>x)`, `[a`, `[aa&&b]`, `[a&&b--c]`, `\1`, `\p{L}`, `a)`, `*`, `[&&]`, `[a-\d]`, `\-`, `(?=a)*`} {
+		if _, err := compileJSPattern(source); err == nil {
+			t.Errorf("compileJSPattern takes %s", source)
+		}
+	}
+
+	for _, source := range []string{`[a&&b]`, `[\-\&]`, `\u{1F600}\x41\u0041`, `(?<year>[0-9]{4})`, `[[a-z]--[aeiou]]`, `(?:)`, `[]`} {
+		if _, err := compileJSPattern(source); err != nil {
+			t.Errorf("compileJSPattern(%s): %v", source, err)
+		}
+	}
+}
@@ -2,70 +2,30 @@
 // https://github.com/KartoshkaPy/Vecty-Props/tree/main/prop
 package prop
 
+//go:generate go run ../cmd/propgen
+
 import (
 	"fmt"
 	"github.com/hexops/vecty"
 	"regexp"
 	"strings"
-	"time"
-)
-
-type (
-	// URL is a reference to something outside
-	// ex: google.com, /api/endpoint, /file.txt
-	URL = string
 )
 
-type AcceptCase = string
+type applyerFunc func(h *vecty.HTML)
 
-const (
-	AcceptCaseMedia AcceptCase = "audio/*"
-	AcceptCaseVideo AcceptCase = "video/*"
-	AcceptCaseImage AcceptCase = "image/*"
-)
-
-// Accept specifies the types of files that the server accepts (only for type="file")
-//
-// <input>
-func Accept(c AcceptCase) vecty.Applyer {
-	return vecty.Property("accept", c)
+func (f applyerFunc) Apply(h *vecty.HTML) {
+	f(h)
 }
 
 // AcceptCharset specifies the character encodings that are to be used for the form submission
 //
 // <form>
 func AcceptCharset(values ...string) vecty.Applyer {
-	return vecty.Property("accept-charset", strings.Join(values, " "))
-}
-
-// AccessKey specifies a shortcut key to activate/focus an element
-//
-// Global Attributes
-func AccessKey(value string) vecty.Applyer {
-	return vecty.Property("accesskey", value)
+	return applyAttr("accept-charset", strings.Join(values, " "))
 }
 
-// Action specifies where to send the form-data when a form is submitted
-//
-// <form>
-func Action(value URL) vecty.Applyer {
-	return vecty.Property("action", value)
-}
-
-// Alt specifies an alternate text when the original element fails to display
-//
-// <area>, <img>, <input>
-func Alt(value string) vecty.Applyer {
-	return vecty.Property("alt", value)
-}
-
-// Async specifies that the script is executed asynchronously (only for external scripts)
-// <script>
-func Async(flag bool) vecty.Applyer {
-	return vecty.Property("async", flag)
-}
-
-// Autocomplete specifies whether the <form> or the <input> element should have autocomplete enabled
+// Autocomplete specifies whether the <form> or the <input> element should have autocomplete enabled,
+// Autofill tells the browser what the field is
 //
 // <form>, <input>
 func Autocomplete(flag bool) vecty.Applyer {
@@ -76,81 +36,47 @@ func Autocomplete(flag bool) vecty.Applyer {
 		stringFlag = "off"
 	}
 
-	return vecty.Property("autocomplete", stringFlag)
-}
-
-// Autofocus specifies that the element should automatically get focus when the page loads
-//
-// <button>, <input>, <select>, <textarea>
-func Autofocus(flag bool) vecty.Applyer {
-	return vecty.Property("autofocus", flag)
-}
-
-// Autoplay specifies that the audio/video will start playing as soon as it is ready
-//
-// <audio>, <video>
-func Autoplay(flag bool) vecty.Applyer {
-	return vecty.Property("autoplay", flag)
-}
-
-// Charset specifies the character encoding
-//
-// <meta>, <script>
-func Charset(value string) vecty.Applyer {
-	return vecty.Property("charset", value)
-}
-
-// Checked specifies that an <input> element should be pre-selected when the page loads (for type="checkbox" or type="radio")
-//
-// <input>
-func Checked(flag bool) vecty.Applyer {
-	return vecty.Property("checked", flag)
+	return applyAttr("autocomplete", stringFlag)
 }
 
-// Cite specifies a URL which explains the quote/deleted/inserted text
+// Autofill specifies the autofill field name of the control and its section, address and contact
 //
-// <blockquote>, <del>, <ins>, <q>
-func Cite(value URL) vecty.Applyer {
-	return vecty.Property("cite", value)
-}
+// <input>, <select>, <textarea>
+func Autofill(value *AutofillTokens) vecty.Applyer {
+	applyer, err := AutofillE(value)
+	if err != nil {
+		panic(err)
+	}
 
-// Cols specifies the visible width of a text area
-//
-// <textarea>
-func Cols(value uint64) vecty.Applyer {
-	return vecty.Property("cols", value)
+	return applyer
 }
 
-// Colspan specifies the number of columns a table cell should span
+// AutofillE is Autofill that returns an error instead of panicking
 //
-// <td>, <th>
-func Colspan(value uint64) vecty.Applyer {
-	return vecty.Property("colspan", value)
-}
+// <input>, <select>, <textarea>
+func AutofillE(value *AutofillTokens) (vecty.Applyer, error) {
+	tokens, err := value.buildAutofill()
+	if err != nil {
+		return nil, err
+	}
 
-// Content gives the value associated with the http-equiv or name attribute
-//
-// <meta>
-func Content(value interface{}) vecty.Applyer {
-	return vecty.Property("content", value)
+	return applyAttr("autocomplete", tokens), nil
 }
 
-// ContentEditable specifies whether the content of an element is editable or not
-//
-// Global Attributes
-func ContentEditable(flag bool) vecty.Applyer {
-	return vecty.Property("contenteditable", flag)
+// ValueError describes a value that can't be used for an attribute
+type ValueError struct {
+	Attr   string
+	Value  string
+	Reason string
 }
 
-// Controls specifies that audio/video controls should be displayed (such as a play/pause button etc)
-//
-// <audio>, <video>
-func Controls(flag bool) vecty.Applyer {
-	return vecty.Property("controls", flag)
+func (e *ValueError) Error() string {
+	return fmt.Sprintf("bad value %q for attribute %s: %s", e.Value, e.Attr, e.Reason)
 }
 
 type CoordsSet interface {
-	buildCoords() string
+	Validate() error
+	buildCoords() (string, error)
 }
 
 type RectCoords struct {
@@ -160,16 +86,24 @@ type RectCoords struct {
 	yBottomRight int64
 }
 
-func (set RectCoords) buildCoords() string {
+func (set RectCoords) Validate() error {
+	_, err := set.buildCoords()
+
+	return err
+}
+
+func (set RectCoords) buildCoords() (string, error) {
+	tpl := fmt.Sprintf("%d,%d,%d,%d",
+		set.xLeftTop, set.yLeftTop, set.xBottomRight, set.yBottomRight)
+
 	if set.xLeftTop >= set.xBottomRight {
-		panic("the first integer must be less than the third")
+		return "", &ValueError{Attr: "coords", Value: tpl, Reason: "the first integer must be less than the third"}
 	}
 	if set.yLeftTop >= set.yBottomRight {
-		panic("the second integer must be less than the fourth")
+		return "", &ValueError{Attr: "coords", Value: tpl, Reason: "the second integer must be less than the fourth"}
 	}
 
-	return fmt.Sprintf("%d,%d,%d,%d",
-		set.xLeftTop, set.yLeftTop, set.xBottomRight, set.yBottomRight)
+	return tpl, nil
 }
 
 func NewRectCoords(xLeftTop, yLeftTop, xBottomRight, yBottomRight int64) *RectCoords {
@@ -187,18 +121,30 @@ type CircleCoords struct {
 	radius string
 }
 
-func (set CircleCoords) buildCoords() string {
+func (set CircleCoords) Validate() error {
+	_, err := set.buildCoords()
+
+	return err
+}
+
+func (set CircleCoords) buildCoords() (string, error) {
+	tpl := fmt.Sprintf("%d,%d,%s",
+		set.x, set.y, set.radius)
+
 	radiusPattern := regexp.MustCompile(`^([0-9]+)(%|)$`)
 
 	if !radiusPattern.MatchString(set.radius) {
+		reason := "the radius must not be empty"
+
 		wrongRunePattern := regexp.MustCompile(`[^0-9]`)
-		wrongRune := wrongRunePattern.FindString(set.radius)[0]
+		if wrongRune := wrongRunePattern.FindString(set.radius); wrongRune != "" {
+			reason = "expected a digit but saw " + wrongRune + " instead"
+		}
 
-		panic("expected a digit but saw " + string(wrongRune) + " instead")
+		return "", &ValueError{Attr: "coords", Value: tpl, Reason: reason}
 	}
 
-	return fmt.Sprintf("%d,%d,%s",
-		set.x, set.y, set.radius)
+	return tpl, nil
 }
 
 func NewCircleCoords(x, y int64, radius string) *CircleCoords {
@@ -225,17 +171,24 @@ type PolyCoords struct {
 	pairs []*PolyCoord
 }
 
-func (set PolyCoords) buildCoords() string {
-	if len(set.pairs) < 3 {
-		panic("a polyline must have at least six comma-separated integers")
-	}
+func (set PolyCoords) Validate() error {
+	_, err := set.buildCoords()
 
+	return err
+}
+
+func (set PolyCoords) buildCoords() (string, error) {
 	var template string
 	for _, pair := range set.pairs {
 		template += fmt.Sprintf("%d,%d,", pair.x, pair.y)
 	}
+	template = strings.TrimSuffix(template, ",")
+
+	if len(set.pairs) < 3 {
+		return "", &ValueError{Attr: "coords", Value: template, Reason: "a polyline must have at least six comma-separated integers"}
+	}
 
-	return strings.TrimSuffix(template, ",")
+	return template, nil
 }
 
 func NewPolyCoords(pairs ...*PolyCoord) *PolyCoords {
@@ -248,259 +201,156 @@ func NewPolyCoords(pairs ...*PolyCoord) *PolyCoords {
 //
 // <area>
 func Coords(value CoordsSet) vecty.Applyer {
-	return vecty.Property("coords", value.buildCoords())
+	applyer, err := CoordsE(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
 }
 
-// Data specifies the URL of the resource to be used by the object
+// CoordsE is Coords that returns an error instead of panicking
 //
-// <object>
-func Data(value URL) vecty.Applyer {
-	return vecty.Property("data", value)
+// <area>
+func CoordsE(value CoordsSet) (vecty.Applyer, error) {
+	coords, err := value.buildCoords()
+	if err != nil {
+		return nil, err
+	}
+
+	return applyAttr("coords", coords), nil
 }
 
 // Datetime specifies the date and time
+// ex: Datetime(NewGlobalDatetime(time.Now())), Datetime(NewDuration(90 * time.Minute))
 //
 // <del>, <ins>, <time>
-func Datetime(value time.Time) vecty.Applyer {
-	return vecty.Property("datetime", value.String())
-}
+func Datetime(value DatetimeValue) vecty.Applyer {
+	applyer, err := DatetimeE(value)
+	if err != nil {
+		panic(err)
+	}
 
-// Default specifies that the track is to be enabled if the user's preferences do not indicate that another track would be more appropriate
-//
-// <track>
-func Default(flag bool) vecty.Applyer {
-	return vecty.Property("default", flag)
+	return applyer
 }
 
-// Defer specifies that the script is executed when the page has finished parsing (only for external scripts)
+// DatetimeE is Datetime that returns an error instead of panicking
 //
-// <script>
-func Defer(flag bool) vecty.Applyer {
-	return vecty.Property("defer", flag)
-}
-
-type DirCase = string
-
-const (
-	DirCaseLTR  DirCase = "ltr"
-	DirCaseRTL  DirCase = "rtl"
-	DirCaseAuto DirCase = "auto"
-)
+// <del>, <ins>, <time>
+func DatetimeE(value DatetimeValue) (vecty.Applyer, error) {
+	tpl, err := formatValue("datetime", value)
+	if err != nil {
+		return nil, err
+	}
 
-// Dir specifies the text direction for the content in an element
-//
-// Global Attributes
-func Dir(c DirCase) vecty.Applyer {
-	return vecty.Property("dir", c)
+	return applyAttr("datetime", tpl), nil
 }
 
 // Dirname specifies that the text direction will be submitted
 //
 // <input>, <textarea>
 func Dirname(value string) vecty.Applyer {
-	return vecty.Property("dirname", value+".dir")
+	return applyAttr("dirname", value+".dir")
 }
 
-// Disabled specifies that the specified element/group of elements should be disabled
-//
-// <button>, <fieldset>, <input>, <optgroup>, <option>, <select>, <textarea>
-func Disabled(flag bool) vecty.Applyer {
-	return vecty.Property("disabled", flag)
-}
-
-// Download specifies that the target will be downloaded when a user clicks on the hyperlink
+// ExternalLink sets href, target, rel and referrerpolicy of a link to another site, options may be nil.
+// It adds noopener and noreferrer when the link opens in a new browsing context and panics on a URL
+// that can't be parsed or whose scheme is not allowed, such as javascript:
+// ex: ExternalLink("https://example.com", NewExternalLinkOptions().UGC())
 //
 // <a>, <area>
-func Download(flag bool) vecty.Applyer {
-	return vecty.Property("download", flag)
-}
+func ExternalLink(href URL, options *ExternalLinkOptions) vecty.Applyer {
+	applyer, err := ExternalLinkE(href, options)
+	if err != nil {
+		panic(err)
+	}
 
-// DownloadWithFilename specifies that the target will be downloaded when a user clicks on the hyperlink
-//
-// <a>, <area>
-func DownloadWithFilename(filename string) vecty.Applyer {
-	return vecty.Property("download", filename)
+	return applyer
 }
 
-// Draggable specifies whether an element is draggable or not
+// ExternalLinkE is ExternalLink that returns an error instead of panicking
 //
-// Global Attributes
-func Draggable(flag bool) vecty.Applyer {
-	return vecty.Property("draggable", flag)
-}
-
-type EnctypeCase = string
+// <a>, <area>
+func ExternalLinkE(href URL, options *ExternalLinkOptions) (vecty.Applyer, error) {
+	if options == nil {
+		options = NewExternalLinkOptions()
+	}
 
-const (
-	EnctypeCaseFormUrlencoded    EnctypeCase = "application/x-www-form-urlencoded"
-	EnctypeCaseMultipartFormData EnctypeCase = "multipart/form-data"
-	EnctypeCasePlainText         EnctypeCase = "text/plain"
-)
+	applyers, err := options.buildExternalLink(href)
+	if err != nil {
+		return nil, err
+	}
 
-// Enctype specifies how the form-data should be encoded when submitting it to the server (only for method="post")
-//
-// <form>
-func Enctype(c EnctypeCase) vecty.Applyer {
-	return vecty.Property("enctype", c)
+	return applyerFunc(func(h *vecty.HTML) {
+		for _, applyer := range applyers {
+			applyer.Apply(h)
+		}
+	}), nil
 }
 
 // For specifies which form element(s) a label/calculation is bound to
 //
 // <label>, <output>
 func For(value EntityRef) vecty.Applyer {
-	return vecty.Property("htmlFor", value)
+	return referenceIDs("for", applyAttr("for", value.id), value)
 }
 
 // Form specifies the name of the form the element belongs to
 //
 // <button>, <fieldset>, <input>, <label>, <meter>, <object>, <output>, <select>, <textarea>
 func Form(value EntityRef) vecty.Applyer {
-	return vecty.Property("form", value)
-}
-
-// FormAction specifies where to send the form-data when a form is submitted. Only for type="submit"
-//
-// <button>, <input>
-func FormAction(value URL) vecty.Applyer {
-	return vecty.Property("formaction", value)
+	return referenceIDs("form", applyAttr("form", value.id), value)
 }
 
 // Headers specifies one or more headers cells a cell is related to
 //
 // <td>, <th>
-func Headers(value EntityRef) vecty.Applyer {
-	return vecty.Property("headers", value)
-}
-
-// Height specifies the height of the element
-//
-// <canvas>, <embed>, <iframe>, <img>, <input>, <object>, <video>
-func Height(value uint64) vecty.Applyer {
-	return vecty.Property("height", value)
-}
-
-// Hidden specifies that an element is not yet, or is no longer, relevant
-//
-// Global Attributes
-func Hidden(flag bool) vecty.Applyer {
-	return vecty.Property("hidden", flag)
-}
-
-// High specifies the range that is considered to be a high value
-//
-// <meter>
-func High(value int64) vecty.Applyer {
-	return vecty.Property("high", value)
-}
-
-// Href specifies the URL of the page the link goes to
-//
-// <a>, <area>, <base>, <link>
-func Href(value URL) vecty.Applyer {
-	return vecty.Property("href", value)
-}
-
-// HrefLang specifies the language of the linked document
-//
-// <a>, <area>, <link>
-func HrefLang(value string) vecty.Applyer {
-	return vecty.Property("hreflang", value)
-}
-
-type httpEquivCase = string
-
-const (
-	httpEquivCaseSecurityPolice httpEquivCase = "content-security-policy"
-	httpEquivContentTypeCase    httpEquivCase = "content-type"
-	httpEquivCaseDefaultStyle   httpEquivCase = "default-style"
-	httpEquivCaseRefresh        httpEquivCase = "refresh"
-)
+func Headers(values ...EntityRef) vecty.Applyer {
+	ids := make([]string, 0, len(values))
+	for _, value := range values {
+		ids = append(ids, value.id)
+	}
 
-// HttpEquiv provides an HTTP header for the information/value of the content attribute
-//
-// <meta>
-func HttpEquiv(c httpEquivCase) vecty.Applyer {
-	return vecty.Property("http-equiv", c)
+	return referenceIDs("headers", applyAttr("headers", strings.Join(ids, " ")), values...)
 }
 
 // ID specifies a unique id for an element
 //
 // Global Attributes
 func ID(value EntityRef) vecty.Applyer {
-	return vecty.Property("id", value)
-}
-
-// IsMap specifies an image as a server-side image map
-//
-// <img>
-func IsMap(flag bool) vecty.Applyer {
-	return vecty.Property("ismap", flag)
-}
-
-type KindCase = string
-
-const (
-	KindCaseCaptions     KindCase = "captions"
-	KindCaseChapters     KindCase = "chapters"
-	KindCaseDescriptions KindCase = "descriptions"
-	KindCaseMetadata     KindCase = "metadata"
-	KindCaseSubtitles    KindCase = "subtitles"
-)
-
-// Kind specifies the kind of text track
-//
-// <track>
-func Kind(c KindCase) vecty.Applyer {
-	return vecty.Property("kind", c)
-}
-
-// Label specifies the title of the text track
-//
-// <track>, <option>, <optgroup>
-func Label(value string) vecty.Applyer {
-	return vecty.Property("label", value)
-}
-
-// Lang specifies the language of the element's content
-//
-// Global Attributes
-func Lang(value string) vecty.Applyer {
-	return vecty.Property("lang", value)
+	return declareID(value, applyAttr("id", value.id))
 }
 
 // List refers to a <datalist> element that contains pre-defined options for an <input> element
 //
 // <input>
 func List(value EntityRef) vecty.Applyer {
-	return vecty.Property("list", value)
+	return referenceIDs("list", applyAttr("list", value.id), value)
 }
 
-// Loop specifies that the audio/video will start over again, every time it is finished
+// Max specifies the maximum value
+// ex: Max(Number(10)), Max(NewWeek(2024, 52))
 //
-// <audio>, <video>
-func Loop(flag bool) vecty.Applyer {
-	return vecty.Property("loop", flag)
-}
+// <input>, <meter>, <progress>
+func Max(value FormValue) vecty.Applyer {
+	applyer, err := MaxE(value)
+	if err != nil {
+		panic(err)
+	}
 
-// Low specifies the range that is considered to be a low value
-//
-// <meter>
-func Low(value int64) vecty.Applyer {
-	return vecty.Property("low", value)
+	return applyer
 }
 
-// Max specifies the maximum value
+// MaxE is Max that returns an error instead of panicking
 //
 // <input>, <meter>, <progress>
-func Max(value string) vecty.Applyer {
-	return vecty.Property("max", value)
-}
+func MaxE(value FormValue) (vecty.Applyer, error) {
+	tpl, err := formatValue("max", value)
+	if err != nil {
+		return nil, err
+	}
 
-// MaxLength specifies the maximum number of characters allowed in an element
-//
-// <input>, <textarea>
-func MaxLength(value uint64) vecty.Applyer {
-	return vecty.Property("maxlength", value)
+	return applyAttr("max", tpl), nil
 }
 
 type MediaQuery string
@@ -584,7 +434,7 @@ func (b MediaQuery) Width(value int64) MediaQuery {
 }
 
 func (b MediaQuery) Height(value int64) MediaQuery {
-	b += MediaQuery(fmt.Sprintf("(heigth: %dpx) ", value))
+	b += MediaQuery(fmt.Sprintf("(height: %dpx) ", value))
 
 	return b
 }
@@ -601,13 +451,6 @@ func (b MediaQuery) DeviceHeight(value int64) MediaQuery {
 	return b
 }
 
-type OrientationCase = string
-
-const (
-	OrientationCaseLandscape = "landscape"
-	OrientationCasePortrait  = "portrait"
-)
-
 func (b MediaQuery) Orientation(t OrientationCase) MediaQuery {
 	b += MediaQuery(fmt.Sprintf("(orientation: %s) ", t))
 
@@ -645,23 +488,26 @@ func (b MediaQuery) Monochrome(value int64) MediaQuery {
 }
 
 func (b MediaQuery) Resolution(value string) MediaQuery {
+	b, err := b.ResolutionE(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return b
+}
+
+// ResolutionE is Resolution that returns an error instead of panicking
+func (b MediaQuery) ResolutionE(value string) (MediaQuery, error) {
 	resolutionPattern := regexp.MustCompile(`^([0-9]+)(dpi|dpcm)$`)
 	if !resolutionPattern.MatchString(value) {
-		panic("unknown dimension")
+		return b, &ValueError{Attr: "media", Value: value, Reason: "unknown dimension, expected dpi or dpcm"}
 	}
 
 	b += MediaQuery(fmt.Sprintf("(resolution: %s) ", value))
 
-	return b
+	return b, nil
 }
 
-type ScanCase = string
-
-const (
-	ScanCaseProgressive = "progressive"
-	ScanCaseInterlace   = "interlace"
-)
-
 func (b MediaQuery) Scan(t ScanCase) MediaQuery {
 	b += MediaQuery(fmt.Sprintf("(scan: %s) ", t))
 
@@ -674,7 +520,7 @@ func (b MediaQuery) Grid(value bool) MediaQuery {
 		intValue = 1
 	}
 
-	b += MediaQuery(fmt.Sprintf("(scan: %d) ", intValue))
+	b += MediaQuery(fmt.Sprintf("(grid: %d) ", intValue))
 
 	return b
 }
@@ -687,231 +533,115 @@ func NewMediaQuery() MediaQuery {
 //
 // <a>, <area>, <link>, <source>, <style>
 func Media(value MediaQuery) vecty.Applyer {
-	return vecty.Property("media", string(value))
-}
-
-type MethodCase = string
-
-const (
-	MethodCaseGET  MethodCase = "GET"
-	MethodCasePOST MethodCase = "POST"
-)
-
-// Method specifies the HTTP method to use when sending form-data
-//
-// <form>
-func Method(c MethodCase) vecty.Applyer {
-	return vecty.Property("method", c)
+	return applyAttr("media", string(value))
 }
 
 // Min specifies a minimum value
+// ex: Min(Number(0)), Min(NewTimeOfDay(8, 0, 0))
 //
 // <input>, <meter>
-func Min(value string) vecty.Applyer {
-	return vecty.Property("min", value)
-}
-
-// Multiply specifies that a user can enter more than one value
-//
-// <input>, <select>
-func Multiply(flag bool) vecty.Applyer {
-	return vecty.Property("multiply", flag)
-}
-
-// Muted specifies that the audio output of the video should be muted
-//
-// <video>, <audio>
-func Muted(flag bool) vecty.Applyer {
-	return vecty.Property("muted", flag)
-}
-
-// NameCase applies to <meta>
-type NameCase = string
-
-const (
-	NameCaseApplication NameCase = "application-name"
-	NameCaseAuthor      NameCase = "author"
-	NameCaseDescription NameCase = "description"
-	NameCaseGenerator   NameCase = "generator"
-	NameCaseKeywords    NameCase = "keywords"
-	NameCaseViewport    NameCase = "viewport"
-)
+func Min(value FormValue) vecty.Applyer {
+	applyer, err := MinE(value)
+	if err != nil {
+		panic(err)
+	}
 
-// Name specifies the name of the element
-//
-// <button>, <fieldset>, <form>, <iframe>, <input>, <map>, <meta>, <object>, <output>, <param>, <select>, <textarea>
-func Name(value NameCase) vecty.Applyer {
-	return vecty.Property("name", value)
+	return applyer
 }
 
-// Novalidate specifies that the form should not be validated when submitted
+// MinE is Min that returns an error instead of panicking
 //
-// <form>
-func Novalidate(flag bool) vecty.Applyer {
-	return vecty.Property("novalidate", flag)
-}
+// <input>, <meter>
+func MinE(value FormValue) (vecty.Applyer, error) {
+	tpl, err := formatValue("min", value)
+	if err != nil {
+		return nil, err
+	}
 
-// Open specifies that the details should be visible (open) to the user
-//
-// <details>
-func Open(flag bool) vecty.Applyer {
-	return vecty.Property("open", flag)
+	return applyAttr("min", tpl), nil
 }
 
-// Optimum specifies what value is the optimal value for the gauge
+// Multiply is Multiple
 //
-// <meter>
-func Optimum(value int64) vecty.Applyer {
-	return vecty.Property("optimum", value)
+// Deprecated: use Multiple
+func Multiply(flag bool) vecty.Applyer {
+	return Multiple(flag)
 }
 
-// Pattern specifies a regular expression that an <input> element's value is checked against
+// Pattern specifies a regular expression that an <input> element's value is checked against.
+// The expression is translated to the browser syntax, see TranslatePattern
 //
 // <input>
 func Pattern(value *regexp.Regexp) vecty.Applyer {
-	return vecty.Property("pattern", value.String())
-}
+	applyer, err := PatternE(value)
+	if err != nil {
+		panic(err)
+	}
 
-// Placeholder specifies a short hint that describes the expected value of the element
-//
-// <input>, <textarea>
-func Placeholder(value string) vecty.Applyer {
-	return vecty.Property("placeholder", value)
+	return applyer
 }
 
-// Poster specifies an image to be shown while the video is downloading, or until the user hits the play button
+// PatternE is Pattern that returns an error instead of panicking
 //
-// <video>
-func Poster(value URL) vecty.Applyer {
-	return vecty.Property("poster", value)
-}
-
-type PreloadCase = string
-
-const (
-	PreloadCaseAuto     PreloadCase = "auto"
-	PreloadCaseMetadata PreloadCase = "metadata"
-	PreloadCaseNone     PreloadCase = "none"
-)
+// <input>
+func PatternE(value *regexp.Regexp) (vecty.Applyer, error) {
+	pattern, err := TranslatePattern(value)
+	if err != nil {
+		return nil, err
+	}
 
-// Preload specifies if and how the author thinks the audio/video should be loaded when the page loads
-//
-// <audio>, <video>
-func Preload(c PreloadCase) vecty.Applyer {
-	return vecty.Property("preload", c)
+	return applyAttr("pattern", pattern), nil
 }
 
-// Readonly specifies that the element is read-only
+// RelCase is the former name of RelToken
 //
-// <input>, <textarea>
-func Readonly(flag bool) vecty.Applyer {
-	return vecty.Property("readonly", flag)
-}
-
-type RelCase = string
-
-const (
-	RelCaseAlternate  RelCase = "alternate"
-	RelCaseAuthor     RelCase = "author"
-	RelCaseBookmark   RelCase = "bookmark"
-	RelCaseExternal   RelCase = "external"
-	RelCaseHelp       RelCase = "help"
-	RelCaseLicence    RelCase = "licence"
-	RelCaseNext       RelCase = "next"
-	RelCaseNofollow   RelCase = "nofollow"
-	RelCaseNoOpener   RelCase = "noopener"
-	RelCaseNoReferrer RelCase = "noreferrer"
-	RelCasePrev       RelCase = "prev"
-	RelCaseSearch     RelCase = "search"
-	RelCaseTag        RelCase = "tag"
-)
+// Deprecated: use RelToken
+type RelCase = RelToken
 
-// Rel specifies the relationship between the current document and the linked document
+// Rel specifies the relationship between the current document and the linked document, the repeated tokens are dropped.
+// It panics when a token is not a link type, RelE also checks the tokens for the element:
+// <form> and <link> take fewer link types than <a>
+// ex: Rel(RelTokenNoOpener, RelTokenNoReferrer, RelTokenNofollow)
 //
 // <a>, <area>, <form>, <link>
-func Rel(c RelCase) vecty.Applyer {
-	return vecty.Property("rel", c)
-}
-
-// Required specifies that the element must be filled out before submitting the form
-//
-// <input>, <select>, <textarea>
-func Required(flag bool) vecty.Applyer {
-	return vecty.Property("required", flag)
-}
-
-// Reversed specifies that the list order should be descending (9,8,7...)
-//
-// <ol>
-func Reversed(flag bool) vecty.Applyer {
-	return vecty.Property("reversed", flag)
-}
-
-// Rows specifies the visible number of lines in a text area
-//
-// <textarea>
-func Rows(value uint64) vecty.Applyer {
-	return vecty.Property("rows", value)
-}
-
-// RowSpan specifies the number of rows a table cell should span
-//
-// <td>, <th>
-func RowSpan(value uint64) vecty.Applyer {
-	return vecty.Property("rowspan", value)
-}
-
-// Sandbox enables an extra set of restrictions for the content in an <iframe>
-//
-// <iframe>
-func Sandbox(flag bool) vecty.Applyer {
-	return vecty.Property("sandbox", flag)
-}
-
-type ScopeCase = string
-
-const (
-	ScopeCaseCol      ScopeCase = "col"
-	ScopeCaseRow      ScopeCase = "row"
-	ScopeCaseColGroup ScopeCase = "colgroup"
-	ScopeCaseRowGroup ScopeCase = "rowgroup"
-)
+func Rel(tokens ...RelToken) vecty.Applyer {
+	applyer, err := buildRel("", tokens)
+	if err != nil {
+		panic(err)
+	}
 
-// Scope specifies whether a header cell is a header for a column, row, or group of columns or rows
-//
-// <th>
-func Scope(c ScopeCase) vecty.Applyer {
-	return vecty.Property("scope", c)
+	return applyer
 }
 
-// Selected specifies that an option should be pre-selected when the page loads
+// RelE is Rel that returns an error instead of panicking, it also reports the tokens that don't apply to the element
+// ex: RelE("form", RelTokenNoOpener, RelTokenNofollow)
 //
-// <option>
-func Selected(flag bool) vecty.Applyer {
-	return vecty.Property("selected", flag)
+// <a>, <area>, <form>, <link>
+func RelE(element string, tokens ...RelToken) (vecty.Applyer, error) {
+	return buildRel(element, tokens)
 }
 
-type ShapeCase = string
+// buildRel checks the tokens for the element, an empty element takes the link types of any of them
+func buildRel(element string, tokens []RelToken) (vecty.Applyer, error) {
+	values := make([]string, 0, len(tokens))
+	seen := map[RelToken]bool{}
+	for _, token := range tokens {
+		if err := token.check(); err != nil {
+			return nil, err
+		}
 
-const (
-	ShapeCaseDefault ShapeCase = "default"
-	ShapeCaseRect    ShapeCase = "rect"
-	ShapeCaseCircle  ShapeCase = "circle"
-	ShapeCasePoly    ShapeCase = "poly"
-)
+		if !seen[token] {
+			values = append(values, token.String())
+			seen[token] = true
+		}
+	}
+	value := strings.Join(values, " ")
 
-// Shape specifies the shape of the area
-//
-// <area>
-func Shape(c ShapeCase) vecty.Applyer {
-	return vecty.Property("shape", c)
-}
+	if err := checkValueOn("rel", element, value); err != nil {
+		return nil, err
+	}
 
-// Size specifies the width, in characters (for <input>) or specifies the number of visible options (for <select>)
-//
-// <input>, <select>
-func Size(value uint64) vecty.Applyer {
-	return vecty.Property("size", value)
+	return applyAttr("rel", value), nil
 }
 
 type SizesSet interface {
@@ -1013,7 +743,7 @@ func (b *LinkSizes) buildSizes() string {
 		tpl += fmt.Sprintf("%dx%d ", width, height)
 	}
 
-	return tpl
+	return strings.TrimSuffix(tpl, " ")
 }
 
 func NewLinkSizes() *LinkSizes {
@@ -1024,144 +754,14 @@ func NewLinkSizes() *LinkSizes {
 //
 // <img>, <link>, <source>
 func Sizes(value SizesSet) vecty.Applyer {
-	return vecty.Property("sizes", value.buildSizes())
-}
-
-// Span specifies the number of columns to span
-//
-// <col>, <colgroup>
-func Span(value uint64) vecty.Applyer {
-	return vecty.Property("span", value)
-}
-
-// SpellCheck specifies whether the element is to have its spelling and grammar checked or not
-//
-// Global Attributes
-func SpellCheck(flag bool) vecty.Applyer {
-	return vecty.Property("spellcheck", flag)
-}
-
-// Src specifies the URL of the media file
-//
-// <audio>, <embed>, <iframe>, <img>, <input>, <script>, <source>, <track>, <video>
-func Src(value URL) vecty.Applyer {
-	return vecty.Property("src", value)
-}
-
-type Attr struct {
-	key,
-	value string
-}
-
-func (b *Attr) build() string {
-	return fmt.Sprintf(`%s="%s"`, b.key, b.value)
-}
-
-func NewAttr(key, value string) *Attr {
-	return &Attr{
-		key:   key,
-		value: value,
-	}
-}
-
-type FakeDOM interface {
-	buildTree() string
-}
-
-type RawNode struct {
-	tree string
-}
-
-func (b *RawNode) Include(nodes ...FakeDOM) *RawNode {
-	for _, node := range nodes {
-		b.tree += node.buildTree()
-	}
-
-	return b
-}
-
-func (b *RawNode) buildTree() string {
-	return b.tree
-}
-
-func NewRawNode(html string) *RawNode {
-	return &RawNode{
-		tree: html,
-	}
-}
-
-func NewEmptyNode(nodes ...Node) *RawNode {
-	var tree string
-	for _, node := range nodes {
-		tree += node.buildTree()
-	}
-
-	return &RawNode{
-		tree: tree,
-	}
-}
-
-type Node struct {
-	name  string
-	attrs []*Attr
-	nodes []FakeDOM
-}
-
-func (b *Node) Include(nodes ...FakeDOM) *Node {
-	b.nodes = append(b.nodes, nodes...)
-
-	return b
-}
-
-func (b *Node) single() bool {
-	singleTags := "area base br col command embed hr img input keygen link meta param source track wbr"
-
-	return strings.Contains(b.name, singleTags)
-}
-
-func (b *Node) buildTree() string {
-	tpl := fmt.Sprintf("<%s", b.name)
-
-	if len(b.attrs) != 0 {
-		attrs := " "
-		for _, attr := range b.attrs {
-			attrs += attr.build()
-		}
-
-		tpl += attrs
-	}
-	tpl += ">"
-
-	for _, node := range b.nodes {
-		tpl += node.buildTree()
-	}
-
-	if !b.single() {
-		tpl += fmt.Sprintf("</%s>", b.name)
-	}
-
-	return tpl
-}
-
-func NewNode(name string, attrs ...*Attr) *Node {
-	return &Node{
-		name:  name,
-		attrs: attrs,
-	}
+	return applyAttr("sizes", value.buildSizes())
 }
 
 // SrcDoc specifies the HTML content of the page to show in the <iframe>
 //
 // <iframe>
 func SrcDoc(value FakeDOM) vecty.Applyer {
-	return vecty.Property("srcdoc", value.buildTree())
-}
-
-// SrcLang specifies the language of the track text data (required if kind="subtitles")
-//
-// <track>
-func SrcLang(value string) vecty.Applyer {
-	return vecty.Property("srclang", value)
+	return applyAttr("srcdoc", value.buildTree())
 }
 
 type SrcsetPair struct {
@@ -1181,13 +781,18 @@ func (b *SrcsetPair) PixelDensity(value uint64) *SrcsetPair {
 	return b
 }
 
-func (b *SrcsetPair) build() string {
+func (b *SrcsetPair) Validate() error {
+	_, err := b.build()
+
+	return err
+}
+
+func (b *SrcsetPair) build() (string, error) {
 	if b.template == "" {
-		panic(fmt.Sprintf("Bad value %s for attribute srcset on element source: Must contain one or more image candidate strings.",
-			b.template))
+		return "", &ValueError{Attr: "srcset", Value: b.url, Reason: "the image candidate has no width or pixel density descriptor"}
 	}
 
-	return b.template
+	return b.template, nil
 }
 
 func NewSrcsetPair(url string) *SrcsetPair {
@@ -1196,25 +801,47 @@ func NewSrcsetPair(url string) *SrcsetPair {
 	}
 }
 
-// Srcset specifies the URL of the image to use in different situations
+// Srcset specifies the URL of the image to use in different situations, no pairs set an empty srcset
 //
 // <img>, <source>
 func Srcset(values ...*SrcsetPair) vecty.Applyer {
-	pairs := make([]string, 0, len(values))
-
-	for _, pair := range values {
-		pairs = append(pairs, pair.build())
+	tpl, err := buildSrcset(values)
+	if err != nil {
+		panic(err)
 	}
-	tpl := strings.Join(pairs, ", ")
 
-	return vecty.Property("srcset", tpl)
+	return applyAttr("srcset", tpl)
 }
 
-// Start specifies the start value of an ordered list
+// SrcsetE is Srcset that returns an error instead of panicking, an empty srcset is an error too
 //
-// <ol>
-func Start(value int64) vecty.Applyer {
-	return vecty.Property("start", value)
+// <img>, <source>
+func SrcsetE(values ...*SrcsetPair) (vecty.Applyer, error) {
+	if len(values) == 0 {
+		return nil, &ValueError{Attr: "srcset", Reason: "must contain one or more image candidate strings"}
+	}
+
+	tpl, err := buildSrcset(values)
+	if err != nil {
+		return nil, err
+	}
+
+	return applyAttr("srcset", tpl), nil
+}
+
+func buildSrcset(values []*SrcsetPair) (string, error) {
+	pairs := make([]string, 0, len(values))
+
+	for _, pair := range values {
+		tpl, err := pair.build()
+		if err != nil {
+			return "", err
+		}
+
+		pairs = append(pairs, tpl)
+	}
+
+	return strings.Join(pairs, ", "), nil
 }
 
 // Step specifies the legal number intervals for an input field
@@ -1228,122 +855,44 @@ func Step(value uint64) vecty.Applyer {
 		stringValue = fmt.Sprintf("%d", value)
 	}
 
-	return vecty.Property("step", stringValue)
-}
-
-// TabIndex specifies the tabbing order of an element
-//
-// Global Attributes
-func TabIndex(value int64) vecty.Applyer {
-	return vecty.Property("tabindex", value)
-}
-
-type TargetCase = string
-
-const (
-	TargetCaseBlank  TargetCase = "_blank"
-	TargetCaseSelf   TargetCase = "_self"
-	TargetCaseParent TargetCase = "_parent"
-	TargetCaseTop    TargetCase = "_top"
-)
-
-// Target specifies the target for where to open the linked document or where to submit the form
-//
-// <a>, <area>, <base>, <form>
-func Target(c TargetCase) vecty.Applyer {
-	return vecty.Property("target", c)
-}
-
-// Title specifies extra information about an element
-//
-// Global Attributes
-func Title(value string) vecty.Applyer {
-	return vecty.Property("title", value)
-}
-
-// Translate specifies whether the content of an element should be translated or not
-//
-// Global Attributes
-func Translate(flag bool) vecty.Applyer {
-	return vecty.Property("translate", flag)
-}
-
-type TypeCase = string
-
-const (
-	TypeCaseButton        TypeCase = "button"
-	TypeCaseCheckbox      TypeCase = "checkbox"
-	TypeCaseColor         TypeCase = "color"
-	TypeCaseDate          TypeCase = "date"
-	TypeCaseDatetime      TypeCase = "datetime"
-	TypeCaseDatetimeLocal TypeCase = "datetime-local"
-	TypeCaseEmail         TypeCase = "email"
-	TypeCaseFile          TypeCase = "file"
-	TypeCaseHidden        TypeCase = "hidden"
-	TypeCaseImage         TypeCase = "image"
-	TypeCaseMonth         TypeCase = "month"
-	TypeCaseNumber        TypeCase = "number"
-	TypeCasePassword      TypeCase = "password"
-	TypeCaseRadio         TypeCase = "radio"
-	TypeCaseRange         TypeCase = "range"
-	TypeCaseMin           TypeCase = "min"
-	TypeCaseMax           TypeCase = "max"
-	TypeCaseValue         TypeCase = "value"
-	TypeCaseStep          TypeCase = "step"
-	TypeCaseReset         TypeCase = "reset"
-	TypeCaseSearch        TypeCase = "search"
-	TypeCaseSubmit        TypeCase = "submit"
-	TypeCaseTel           TypeCase = "tel"
-	TypeCaseText          TypeCase = "text"
-	TypeCaseTime          TypeCase = "time"
-	TypeCaseURL           TypeCase = "url"
-	TypeCaseWeek          TypeCase = "week"
-	TypeCaseList          TypeCase = "list"
-	TypeCaseContext       TypeCase = "context"
-	TypeCaseToolbar       TypeCase = "toolbar"
-	TypeCaseModule        TypeCase = "module"
-)
-
-// Type specifies the type of element
-//
-// <a>, <button>, <embed>, <input>, <link>, <menu>, <object>, <script>, <source>, <style>
-func Type(c TypeCase) vecty.Applyer {
-	return vecty.Property("type", c)
+	return applyAttr("step", stringValue)
 }
 
 // UseMap specifies an image as a client-side image map
 //
 // <img>, <object>
 func UseMap(value EntityRef) vecty.Applyer {
-	return vecty.Property("usemap", "#"+value)
+	return referenceIDs("usemap", applyAttr("usemap", "#"+value.id), value)
 }
 
-// Value specifies the value of the element
+// Value specifies the value of the element, a FormValue is written in its microsyntax
+// and anything else as is
 //
 // <button>, <input>, <li>, <option>, <meter>, <progress>, <param>
 func Value(propValue interface{}) vecty.Applyer {
-	return vecty.Property("value", propValue)
-}
+	applyer, err := ValueE(propValue)
+	if err != nil {
+		panic(err)
+	}
 
-// Width specifies the width of the element
-//
-// <canvas>, <embed>, <iframe>, <img>, <input>, <object>, <video>
-func Width(value uint64) vecty.Applyer {
-	return vecty.Property("width", value)
+	return applyer
 }
 
-type WrapCase = string
+// ValueE is Value that returns an error instead of panicking
+//
+// <button>, <input>, <li>, <option>, <meter>, <progress>, <param>
+func ValueE(propValue interface{}) (vecty.Applyer, error) {
+	v, ok := propValue.(FormValue)
+	if !ok {
+		return applyAttr("value", propValue), nil
+	}
 
-const (
-	WrapCaseSoft WrapCase = "soft"
-	WrapCaseHard WrapCase = "hard"
-)
+	tpl, err := formatValue("value", v)
+	if err != nil {
+		return nil, err
+	}
 
-// Wrap specifies how the text in a text area is to be wrapped when submitted in a form.
-//
-// <textarea>
-func Wrap(c WrapCase) vecty.Applyer {
-	return vecty.Property("wrap", c)
+	return applyAttr("value", tpl), nil
 }
 
 // On used when you need to pass the raw javascript
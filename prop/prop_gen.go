@@ -0,0 +1,2277 @@
+// Code generated by propgen from spec; DO NOT EDIT.
+
+package prop
+
+import (
+	"strings"
+
+	"github.com/hexops/vecty"
+)
+
+type AcceptCase string
+
+const (
+	AcceptCaseMedia AcceptCase = "audio/*"
+	AcceptCaseVideo AcceptCase = "video/*"
+	AcceptCaseImage AcceptCase = "image/*"
+)
+
+// AcceptCaseValues returns the constants of AcceptCase, the deprecated ones are left out
+func AcceptCaseValues() []AcceptCase {
+	return []AcceptCase{AcceptCaseMedia, AcceptCaseVideo, AcceptCaseImage}
+}
+
+// ParseAcceptCase returns the constant that matches the value ignoring case, or the value when it is valid
+func ParseAcceptCase(value string) (AcceptCase, error) {
+	for _, c := range AcceptCaseValues() {
+		if strings.EqualFold(string(c), value) {
+			return c, nil
+		}
+	}
+
+	c := AcceptCase(value)
+	if err := c.check(); err != nil {
+		return "", err
+	}
+
+	return c, nil
+}
+
+// Valid reports whether the accept attribute allows the value
+func (c AcceptCase) Valid() bool {
+	return checkValue("accept", string(c)) == nil
+}
+
+func (c AcceptCase) String() string {
+	return string(c)
+}
+
+func (c AcceptCase) check() error {
+	return checkValue("accept", string(c))
+}
+
+// Accept specifies the types of files that the server accepts (only for type="file"), it panics when the value is not valid, see AcceptE
+//
+// <input>
+func Accept(c AcceptCase) vecty.Applyer {
+	applyer, err := AcceptE(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// AcceptE is Accept that returns an error instead of panicking
+//
+// <input>
+func AcceptE(c AcceptCase) (vecty.Applyer, error) {
+	if err := c.check(); err != nil {
+		return nil, err
+	}
+
+	return applyAttr("accept", c.String()), nil
+}
+
+// AccessKey specifies a shortcut key to activate/focus an element
+//
+// Global Attributes
+func AccessKey(value string) vecty.Applyer {
+	return applyAttr("accesskey", value)
+}
+
+// Action specifies where to send the form-data when a form is submitted, it panics when the value is not valid, see ActionE.
+// User input should go through ParseURL
+//
+// <form>
+func Action(value URL) vecty.Applyer {
+	applyer, err := ActionE(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// ActionE is Action that returns an error instead of panicking
+//
+// <form>
+func ActionE(value URL) (vecty.Applyer, error) {
+	if err := value.check(); err != nil {
+		return nil, err
+	}
+
+	return applyAttr("action", value.String()), nil
+}
+
+// Alt specifies an alternate text when the original element fails to display
+//
+// <area>, <img>, <input>
+func Alt(value string) vecty.Applyer {
+	return applyAttr("alt", value)
+}
+
+// Async specifies that the script is executed asynchronously (only for external scripts)
+//
+// <script>
+func Async(flag bool) vecty.Applyer {
+	return applyAttr("async", flag)
+}
+
+// Autofocus specifies that the element should automatically get focus when the page loads
+//
+// Global Attributes
+func Autofocus(flag bool) vecty.Applyer {
+	return applyAttr("autofocus", flag)
+}
+
+// Autoplay specifies that the audio/video will start playing as soon as it is ready
+//
+// <audio>, <video>
+func Autoplay(flag bool) vecty.Applyer {
+	return applyAttr("autoplay", flag)
+}
+
+// ButtonTypeCase is the type of <button>
+type ButtonTypeCase string
+
+const (
+	ButtonTypeCaseSubmit ButtonTypeCase = "submit"
+	ButtonTypeCaseReset  ButtonTypeCase = "reset"
+	ButtonTypeCaseButton ButtonTypeCase = "button"
+)
+
+// ButtonTypeCaseValues returns the constants of ButtonTypeCase, the deprecated ones are left out
+func ButtonTypeCaseValues() []ButtonTypeCase {
+	return []ButtonTypeCase{ButtonTypeCaseSubmit, ButtonTypeCaseReset, ButtonTypeCaseButton}
+}
+
+// ParseButtonTypeCase returns the constant that matches the value ignoring case
+func ParseButtonTypeCase(value string) (ButtonTypeCase, error) {
+	for _, c := range ButtonTypeCaseValues() {
+		if strings.EqualFold(string(c), value) {
+			return c, nil
+		}
+	}
+
+	c := ButtonTypeCase(value)
+	if err := c.check(); err != nil {
+		return "", err
+	}
+
+	return c, nil
+}
+
+// Valid reports whether the value is one of the constants
+func (c ButtonTypeCase) Valid() bool {
+	switch c {
+	case ButtonTypeCaseSubmit, ButtonTypeCaseReset, ButtonTypeCaseButton:
+		return true
+	}
+
+	return false
+}
+
+func (c ButtonTypeCase) String() string {
+	return string(c)
+}
+
+func (c ButtonTypeCase) check() error {
+	if c.Valid() {
+		return nil
+	}
+
+	return &ValueError{Attr: "type", Value: string(c), Reason: "expected one of submit, reset, button"}
+}
+
+// ButtonType specifies the behavior of the button, it panics when the value is not valid, see ButtonTypeE
+//
+// <button>
+func ButtonType(c ButtonTypeCase) vecty.Applyer {
+	applyer, err := ButtonTypeE(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// ButtonTypeE is ButtonType that returns an error instead of panicking
+//
+// <button>
+func ButtonTypeE(c ButtonTypeCase) (vecty.Applyer, error) {
+	if err := c.check(); err != nil {
+		return nil, err
+	}
+
+	return applyAttr("type", c.String()), nil
+}
+
+// Charset specifies the character encoding
+//
+// <meta>, <script>
+func Charset(value string) vecty.Applyer {
+	return applyAttr("charset", value)
+}
+
+// Checked specifies that an <input> element should be pre-selected when the page loads (for type="checkbox" or type="radio")
+//
+// <input>
+func Checked(flag bool) vecty.Applyer {
+	return applyAttr("checked", flag)
+}
+
+// Cite specifies a URL which explains the quote/deleted/inserted text, it panics when the value is not valid, see CiteE.
+// User input should go through ParseURL
+//
+// <blockquote>, <del>, <ins>, <q>
+func Cite(value URL) vecty.Applyer {
+	applyer, err := CiteE(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// CiteE is Cite that returns an error instead of panicking
+//
+// <blockquote>, <del>, <ins>, <q>
+func CiteE(value URL) (vecty.Applyer, error) {
+	if err := value.check(); err != nil {
+		return nil, err
+	}
+
+	return applyAttr("cite", value.String()), nil
+}
+
+// Cols specifies the visible width of a text area
+//
+// <textarea>
+func Cols(value uint64) vecty.Applyer {
+	return applyAttr("cols", value)
+}
+
+// Colspan specifies the number of columns a table cell should span
+//
+// <td>, <th>
+func Colspan(value uint64) vecty.Applyer {
+	return applyAttr("colspan", value)
+}
+
+// Content gives the value associated with the http-equiv or name attribute
+//
+// <meta>
+func Content(value interface{}) vecty.Applyer {
+	return applyAttr("content", value)
+}
+
+// ContentEditable specifies whether the content of an element is editable or not
+//
+// Global Attributes
+func ContentEditable(flag bool) vecty.Applyer {
+	return applyAttr("contenteditable", flag)
+}
+
+// Controls specifies that audio/video controls should be displayed (such as a play/pause button etc)
+//
+// <audio>, <video>
+func Controls(flag bool) vecty.Applyer {
+	return applyAttr("controls", flag)
+}
+
+// Data specifies the URL of the resource to be used by the object, it panics when the value is not valid, see DataE.
+// User input should go through ParseURL
+//
+// <object>
+func Data(value URL) vecty.Applyer {
+	applyer, err := DataE(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// DataE is Data that returns an error instead of panicking
+//
+// <object>
+func DataE(value URL) (vecty.Applyer, error) {
+	if err := value.check(); err != nil {
+		return nil, err
+	}
+
+	return applyAttr("data", value.String()), nil
+}
+
+// Default specifies that the track is to be enabled if the user's preferences do not indicate that another track would be more appropriate
+//
+// <track>
+func Default(flag bool) vecty.Applyer {
+	return applyAttr("default", flag)
+}
+
+// Defer specifies that the script is executed when the page has finished parsing (only for external scripts)
+//
+// <script>
+func Defer(flag bool) vecty.Applyer {
+	return applyAttr("defer", flag)
+}
+
+type DirCase string
+
+const (
+	DirCaseLTR  DirCase = "ltr"
+	DirCaseRTL  DirCase = "rtl"
+	DirCaseAuto DirCase = "auto"
+)
+
+// DirCaseValues returns the constants of DirCase, the deprecated ones are left out
+func DirCaseValues() []DirCase {
+	return []DirCase{DirCaseLTR, DirCaseRTL, DirCaseAuto}
+}
+
+// ParseDirCase returns the constant that matches the value ignoring case
+func ParseDirCase(value string) (DirCase, error) {
+	for _, c := range DirCaseValues() {
+		if strings.EqualFold(string(c), value) {
+			return c, nil
+		}
+	}
+
+	c := DirCase(value)
+	if err := c.check(); err != nil {
+		return "", err
+	}
+
+	return c, nil
+}
+
+// Valid reports whether the value is one of the constants
+func (c DirCase) Valid() bool {
+	switch c {
+	case DirCaseLTR, DirCaseRTL, DirCaseAuto:
+		return true
+	}
+
+	return false
+}
+
+func (c DirCase) String() string {
+	return string(c)
+}
+
+func (c DirCase) check() error {
+	if c.Valid() {
+		return nil
+	}
+
+	return &ValueError{Attr: "dir", Value: string(c), Reason: "expected one of ltr, rtl, auto"}
+}
+
+// Dir specifies the text direction for the content in an element, it panics when the value is not valid, see DirE
+//
+// Global Attributes
+func Dir(c DirCase) vecty.Applyer {
+	applyer, err := DirE(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// DirE is Dir that returns an error instead of panicking
+//
+// Global Attributes
+func DirE(c DirCase) (vecty.Applyer, error) {
+	if err := c.check(); err != nil {
+		return nil, err
+	}
+
+	return applyAttr("dir", c.String()), nil
+}
+
+// Disabled specifies that the specified element/group of elements should be disabled
+//
+// <button>, <fieldset>, <input>, <link>, <optgroup>, <option>, <select>, <textarea>
+func Disabled(flag bool) vecty.Applyer {
+	return applyAttr("disabled", flag)
+}
+
+// Download specifies that the target will be downloaded when a user clicks on the hyperlink
+//
+// <a>, <area>
+func Download(flag bool) vecty.Applyer {
+	return applyAttr("download", flag)
+}
+
+// DownloadWithFilename specifies that the target will be downloaded when a user clicks on the hyperlink
+//
+// <a>, <area>
+func DownloadWithFilename(filename string) vecty.Applyer {
+	return applyAttr("download", filename)
+}
+
+// Draggable specifies whether an element is draggable or not
+//
+// Global Attributes
+func Draggable(flag bool) vecty.Applyer {
+	return applyAttr("draggable", flag)
+}
+
+type EnctypeCase string
+
+const (
+	EnctypeCaseFormUrlencoded    EnctypeCase = "application/x-www-form-urlencoded"
+	EnctypeCaseMultipartFormData EnctypeCase = "multipart/form-data"
+	EnctypeCasePlainText         EnctypeCase = "text/plain"
+)
+
+// EnctypeCaseValues returns the constants of EnctypeCase, the deprecated ones are left out
+func EnctypeCaseValues() []EnctypeCase {
+	return []EnctypeCase{EnctypeCaseFormUrlencoded, EnctypeCaseMultipartFormData, EnctypeCasePlainText}
+}
+
+// ParseEnctypeCase returns the constant that matches the value ignoring case
+func ParseEnctypeCase(value string) (EnctypeCase, error) {
+	for _, c := range EnctypeCaseValues() {
+		if strings.EqualFold(string(c), value) {
+			return c, nil
+		}
+	}
+
+	c := EnctypeCase(value)
+	if err := c.check(); err != nil {
+		return "", err
+	}
+
+	return c, nil
+}
+
+// Valid reports whether the value is one of the constants
+func (c EnctypeCase) Valid() bool {
+	switch c {
+	case EnctypeCaseFormUrlencoded, EnctypeCaseMultipartFormData, EnctypeCasePlainText:
+		return true
+	}
+
+	return false
+}
+
+func (c EnctypeCase) String() string {
+	return string(c)
+}
+
+func (c EnctypeCase) check() error {
+	if c.Valid() {
+		return nil
+	}
+
+	return &ValueError{Attr: "enctype", Value: string(c), Reason: "expected one of application/x-www-form-urlencoded, multipart/form-data, text/plain"}
+}
+
+// Enctype specifies how the form-data should be encoded when submitting it to the server (only for method="post"), it panics when the value is not valid, see EnctypeE
+//
+// <form>
+func Enctype(c EnctypeCase) vecty.Applyer {
+	applyer, err := EnctypeE(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// EnctypeE is Enctype that returns an error instead of panicking
+//
+// <form>
+func EnctypeE(c EnctypeCase) (vecty.Applyer, error) {
+	if err := c.check(); err != nil {
+		return nil, err
+	}
+
+	return applyAttr("enctype", c.String()), nil
+}
+
+// FormAction specifies where to send the form-data when a form is submitted. Only for type="submit", it panics when the value is not valid, see FormActionE.
+// User input should go through ParseURL
+//
+// <button>, <input>
+func FormAction(value URL) vecty.Applyer {
+	applyer, err := FormActionE(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// FormActionE is FormAction that returns an error instead of panicking
+//
+// <button>, <input>
+func FormActionE(value URL) (vecty.Applyer, error) {
+	if err := value.check(); err != nil {
+		return nil, err
+	}
+
+	return applyAttr("formaction", value.String()), nil
+}
+
+// Height specifies the height of the element
+//
+// <canvas>, <embed>, <iframe>, <img>, <input>, <object>, <source>, <video>
+func Height(value uint64) vecty.Applyer {
+	return applyAttr("height", value)
+}
+
+// Hidden specifies that an element is not yet, or is no longer, relevant
+//
+// Global Attributes
+func Hidden(flag bool) vecty.Applyer {
+	return applyAttr("hidden", flag)
+}
+
+// High specifies the range that is considered to be a high value
+//
+// <meter>
+func High(value int64) vecty.Applyer {
+	return applyAttr("high", value)
+}
+
+// Href specifies the URL of the page the link goes to, it panics when the value is not valid, see HrefE.
+// User input should go through ParseURL
+//
+// <a>, <area>, <base>, <link>
+func Href(value URL) vecty.Applyer {
+	applyer, err := HrefE(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// HrefE is Href that returns an error instead of panicking
+//
+// <a>, <area>, <base>, <link>
+func HrefE(value URL) (vecty.Applyer, error) {
+	if err := value.check(); err != nil {
+		return nil, err
+	}
+
+	return applyAttr("href", value.String()), nil
+}
+
+// HrefLang specifies the language of the linked document
+//
+// <a>, <link>
+func HrefLang(value string) vecty.Applyer {
+	return applyAttr("hreflang", value)
+}
+
+type HttpEquivCase string
+
+const (
+	HttpEquivCaseContentSecurityPolicy HttpEquivCase = "content-security-policy"
+	HttpEquivCaseContentType           HttpEquivCase = "content-type"
+	HttpEquivCaseDefaultStyle          HttpEquivCase = "default-style"
+	HttpEquivCaseXUACompatible         HttpEquivCase = "x-ua-compatible"
+	HttpEquivCaseRefresh               HttpEquivCase = "refresh"
+)
+
+// HttpEquivCaseValues returns the constants of HttpEquivCase, the deprecated ones are left out
+func HttpEquivCaseValues() []HttpEquivCase {
+	return []HttpEquivCase{HttpEquivCaseContentSecurityPolicy, HttpEquivCaseContentType, HttpEquivCaseDefaultStyle, HttpEquivCaseXUACompatible, HttpEquivCaseRefresh}
+}
+
+// ParseHttpEquivCase returns the constant that matches the value ignoring case
+func ParseHttpEquivCase(value string) (HttpEquivCase, error) {
+	for _, c := range HttpEquivCaseValues() {
+		if strings.EqualFold(string(c), value) {
+			return c, nil
+		}
+	}
+
+	c := HttpEquivCase(value)
+	if err := c.check(); err != nil {
+		return "", err
+	}
+
+	return c, nil
+}
+
+// Valid reports whether the value is one of the constants
+func (c HttpEquivCase) Valid() bool {
+	switch c {
+	case HttpEquivCaseContentSecurityPolicy, HttpEquivCaseContentType, HttpEquivCaseDefaultStyle, HttpEquivCaseXUACompatible, HttpEquivCaseRefresh:
+		return true
+	}
+
+	return false
+}
+
+func (c HttpEquivCase) String() string {
+	return string(c)
+}
+
+func (c HttpEquivCase) check() error {
+	if c.Valid() {
+		return nil
+	}
+
+	return &ValueError{Attr: "http-equiv", Value: string(c), Reason: "expected one of content-security-policy, content-type, default-style, x-ua-compatible, refresh"}
+}
+
+// HttpEquiv provides an HTTP header for the information/value of the content attribute, it panics when the value is not valid, see HttpEquivE
+//
+// <meta>
+func HttpEquiv(c HttpEquivCase) vecty.Applyer {
+	applyer, err := HttpEquivE(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// HttpEquivE is HttpEquiv that returns an error instead of panicking
+//
+// <meta>
+func HttpEquivE(c HttpEquivCase) (vecty.Applyer, error) {
+	if err := c.check(); err != nil {
+		return nil, err
+	}
+
+	return applyAttr("http-equiv", c.String()), nil
+}
+
+// InputTypeCase is the type of <input>
+type InputTypeCase string
+
+const (
+	InputTypeCaseButton        InputTypeCase = "button"
+	InputTypeCaseCheckbox      InputTypeCase = "checkbox"
+	InputTypeCaseColor         InputTypeCase = "color"
+	InputTypeCaseDate          InputTypeCase = "date"
+	InputTypeCaseDatetimeLocal InputTypeCase = "datetime-local"
+	InputTypeCaseEmail         InputTypeCase = "email"
+	InputTypeCaseFile          InputTypeCase = "file"
+	InputTypeCaseHidden        InputTypeCase = "hidden"
+	InputTypeCaseImage         InputTypeCase = "image"
+	InputTypeCaseMonth         InputTypeCase = "month"
+	InputTypeCaseNumber        InputTypeCase = "number"
+	InputTypeCasePassword      InputTypeCase = "password"
+	InputTypeCaseRadio         InputTypeCase = "radio"
+	InputTypeCaseRange         InputTypeCase = "range"
+	InputTypeCaseReset         InputTypeCase = "reset"
+	InputTypeCaseSearch        InputTypeCase = "search"
+	InputTypeCaseSubmit        InputTypeCase = "submit"
+	InputTypeCaseTel           InputTypeCase = "tel"
+	InputTypeCaseText          InputTypeCase = "text"
+	InputTypeCaseTime          InputTypeCase = "time"
+	InputTypeCaseURL           InputTypeCase = "url"
+	InputTypeCaseWeek          InputTypeCase = "week"
+)
+
+// InputTypeCaseValues returns the constants of InputTypeCase, the deprecated ones are left out
+func InputTypeCaseValues() []InputTypeCase {
+	return []InputTypeCase{InputTypeCaseButton, InputTypeCaseCheckbox, InputTypeCaseColor, InputTypeCaseDate, InputTypeCaseDatetimeLocal, InputTypeCaseEmail, InputTypeCaseFile, InputTypeCaseHidden, InputTypeCaseImage, InputTypeCaseMonth, InputTypeCaseNumber, InputTypeCasePassword, InputTypeCaseRadio, InputTypeCaseRange, InputTypeCaseReset, InputTypeCaseSearch, InputTypeCaseSubmit, InputTypeCaseTel, InputTypeCaseText, InputTypeCaseTime, InputTypeCaseURL, InputTypeCaseWeek}
+}
+
+// ParseInputTypeCase returns the constant that matches the value ignoring case
+func ParseInputTypeCase(value string) (InputTypeCase, error) {
+	for _, c := range InputTypeCaseValues() {
+		if strings.EqualFold(string(c), value) {
+			return c, nil
+		}
+	}
+
+	c := InputTypeCase(value)
+	if err := c.check(); err != nil {
+		return "", err
+	}
+
+	return c, nil
+}
+
+// Valid reports whether the value is one of the constants
+func (c InputTypeCase) Valid() bool {
+	switch c {
+	case InputTypeCaseButton, InputTypeCaseCheckbox, InputTypeCaseColor, InputTypeCaseDate, InputTypeCaseDatetimeLocal, InputTypeCaseEmail, InputTypeCaseFile, InputTypeCaseHidden, InputTypeCaseImage, InputTypeCaseMonth, InputTypeCaseNumber, InputTypeCasePassword, InputTypeCaseRadio, InputTypeCaseRange, InputTypeCaseReset, InputTypeCaseSearch, InputTypeCaseSubmit, InputTypeCaseTel, InputTypeCaseText, InputTypeCaseTime, InputTypeCaseURL, InputTypeCaseWeek:
+		return true
+	}
+
+	return false
+}
+
+func (c InputTypeCase) String() string {
+	return string(c)
+}
+
+func (c InputTypeCase) check() error {
+	if c.Valid() {
+		return nil
+	}
+
+	return &ValueError{Attr: "type", Value: string(c), Reason: "expected one of button, checkbox, color, date, datetime-local, email, file, hidden, image, month, number, password, radio, range, reset, search, submit, tel, text, time, url, week"}
+}
+
+// InputType specifies the kind of control, it panics when the value is not valid, see InputTypeE
+//
+// <input>
+func InputType(c InputTypeCase) vecty.Applyer {
+	applyer, err := InputTypeE(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// InputTypeE is InputType that returns an error instead of panicking
+//
+// <input>
+func InputTypeE(c InputTypeCase) (vecty.Applyer, error) {
+	if err := c.check(); err != nil {
+		return nil, err
+	}
+
+	return applyAttr("type", c.String()), nil
+}
+
+// IsMap specifies an image as a server-side image map
+//
+// <img>
+func IsMap(flag bool) vecty.Applyer {
+	return applyAttr("ismap", flag)
+}
+
+type KindCase string
+
+const (
+	KindCaseCaptions     KindCase = "captions"
+	KindCaseChapters     KindCase = "chapters"
+	KindCaseDescriptions KindCase = "descriptions"
+	KindCaseMetadata     KindCase = "metadata"
+	KindCaseSubtitles    KindCase = "subtitles"
+)
+
+// KindCaseValues returns the constants of KindCase, the deprecated ones are left out
+func KindCaseValues() []KindCase {
+	return []KindCase{KindCaseCaptions, KindCaseChapters, KindCaseDescriptions, KindCaseMetadata, KindCaseSubtitles}
+}
+
+// ParseKindCase returns the constant that matches the value ignoring case
+func ParseKindCase(value string) (KindCase, error) {
+	for _, c := range KindCaseValues() {
+		if strings.EqualFold(string(c), value) {
+			return c, nil
+		}
+	}
+
+	c := KindCase(value)
+	if err := c.check(); err != nil {
+		return "", err
+	}
+
+	return c, nil
+}
+
+// Valid reports whether the value is one of the constants
+func (c KindCase) Valid() bool {
+	switch c {
+	case KindCaseCaptions, KindCaseChapters, KindCaseDescriptions, KindCaseMetadata, KindCaseSubtitles:
+		return true
+	}
+
+	return false
+}
+
+func (c KindCase) String() string {
+	return string(c)
+}
+
+func (c KindCase) check() error {
+	if c.Valid() {
+		return nil
+	}
+
+	return &ValueError{Attr: "kind", Value: string(c), Reason: "expected one of captions, chapters, descriptions, metadata, subtitles"}
+}
+
+// Kind specifies the kind of text track, it panics when the value is not valid, see KindE
+//
+// <track>
+func Kind(c KindCase) vecty.Applyer {
+	applyer, err := KindE(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// KindE is Kind that returns an error instead of panicking
+//
+// <track>
+func KindE(c KindCase) (vecty.Applyer, error) {
+	if err := c.check(); err != nil {
+		return nil, err
+	}
+
+	return applyAttr("kind", c.String()), nil
+}
+
+// Label specifies the title of the text track
+//
+// <optgroup>, <option>, <track>
+func Label(value string) vecty.Applyer {
+	return applyAttr("label", value)
+}
+
+// Lang specifies the language of the element's content
+//
+// Global Attributes
+func Lang(value string) vecty.Applyer {
+	return applyAttr("lang", value)
+}
+
+// Loop specifies that the audio/video will start over again, every time it is finished
+//
+// <audio>, <video>
+func Loop(flag bool) vecty.Applyer {
+	return applyAttr("loop", flag)
+}
+
+// Low specifies the range that is considered to be a low value
+//
+// <meter>
+func Low(value int64) vecty.Applyer {
+	return applyAttr("low", value)
+}
+
+// MaxLength specifies the maximum number of characters allowed in an element
+//
+// <input>, <textarea>
+func MaxLength(value uint64) vecty.Applyer {
+	return applyAttr("maxlength", value)
+}
+
+type MethodCase string
+
+const (
+	MethodCaseGET    MethodCase = "GET"
+	MethodCasePOST   MethodCase = "POST"
+	MethodCaseDialog MethodCase = "dialog"
+)
+
+// MethodCaseValues returns the constants of MethodCase, the deprecated ones are left out
+func MethodCaseValues() []MethodCase {
+	return []MethodCase{MethodCaseGET, MethodCasePOST, MethodCaseDialog}
+}
+
+// ParseMethodCase returns the constant that matches the value ignoring case
+func ParseMethodCase(value string) (MethodCase, error) {
+	for _, c := range MethodCaseValues() {
+		if strings.EqualFold(string(c), value) {
+			return c, nil
+		}
+	}
+
+	c := MethodCase(value)
+	if err := c.check(); err != nil {
+		return "", err
+	}
+
+	return c, nil
+}
+
+// Valid reports whether the value is one of the constants
+func (c MethodCase) Valid() bool {
+	switch c {
+	case MethodCaseGET, MethodCasePOST, MethodCaseDialog:
+		return true
+	}
+
+	return false
+}
+
+func (c MethodCase) String() string {
+	return string(c)
+}
+
+func (c MethodCase) check() error {
+	if c.Valid() {
+		return nil
+	}
+
+	return &ValueError{Attr: "method", Value: string(c), Reason: "expected one of GET, POST, dialog"}
+}
+
+// Method specifies the HTTP method to use when sending form-data, it panics when the value is not valid, see MethodE
+//
+// <form>
+func Method(c MethodCase) vecty.Applyer {
+	applyer, err := MethodE(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// MethodE is Method that returns an error instead of panicking
+//
+// <form>
+func MethodE(c MethodCase) (vecty.Applyer, error) {
+	if err := c.check(); err != nil {
+		return nil, err
+	}
+
+	return applyAttr("method", c.String()), nil
+}
+
+// MIMEType specifies the MIME type of the linked or embedded resource, it panics when the value is not valid, see MIMETypeE
+//
+// <a>, <embed>, <link>, <object>, <source>
+func MIMEType(value MIME) vecty.Applyer {
+	applyer, err := MIMETypeE(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// MIMETypeE is MIMEType that returns an error instead of panicking
+//
+// <a>, <embed>, <link>, <object>, <source>
+func MIMETypeE(value MIME) (vecty.Applyer, error) {
+	if err := value.check(); err != nil {
+		return nil, err
+	}
+
+	return applyAttr("type", value.String()), nil
+}
+
+// Multiple specifies that a user can enter more than one value
+//
+// <input>, <select>
+func Multiple(flag bool) vecty.Applyer {
+	return applyAttr("multiple", flag)
+}
+
+// Muted specifies that the audio output of the video should be muted
+//
+// <audio>, <video>
+func Muted(flag bool) vecty.Applyer {
+	return applyAttr("muted", flag)
+}
+
+// NameCase applies to <meta>
+type NameCase string
+
+const (
+	NameCaseApplication NameCase = "application-name"
+	NameCaseAuthor      NameCase = "author"
+	NameCaseDescription NameCase = "description"
+	NameCaseGenerator   NameCase = "generator"
+	NameCaseKeywords    NameCase = "keywords"
+	NameCaseViewport    NameCase = "viewport"
+)
+
+// NameCaseValues returns the constants of NameCase, the deprecated ones are left out
+func NameCaseValues() []NameCase {
+	return []NameCase{NameCaseApplication, NameCaseAuthor, NameCaseDescription, NameCaseGenerator, NameCaseKeywords, NameCaseViewport}
+}
+
+// ParseNameCase returns the constant that matches the value ignoring case, or the value when it is valid
+func ParseNameCase(value string) (NameCase, error) {
+	for _, c := range NameCaseValues() {
+		if strings.EqualFold(string(c), value) {
+			return c, nil
+		}
+	}
+
+	c := NameCase(value)
+	if err := c.check(); err != nil {
+		return "", err
+	}
+
+	return c, nil
+}
+
+// Valid reports whether the name attribute allows the value
+func (c NameCase) Valid() bool {
+	return checkValue("name", string(c)) == nil
+}
+
+func (c NameCase) String() string {
+	return string(c)
+}
+
+func (c NameCase) check() error {
+	return checkValue("name", string(c))
+}
+
+// Name specifies the name of the element, it panics when the value is not valid, see NameE
+//
+// <button>, <details>, <fieldset>, <form>, <iframe>, <input>, <map>, <meta>, <object>, <output>, <select>, <slot>, <textarea>
+func Name(value NameCase) vecty.Applyer {
+	applyer, err := NameE(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// NameE is Name that returns an error instead of panicking
+//
+// <button>, <details>, <fieldset>, <form>, <iframe>, <input>, <map>, <meta>, <object>, <output>, <select>, <slot>, <textarea>
+func NameE(value NameCase) (vecty.Applyer, error) {
+	if err := value.check(); err != nil {
+		return nil, err
+	}
+
+	return applyAttr("name", value.String()), nil
+}
+
+// Novalidate specifies that the form should not be validated when submitted
+//
+// <form>
+func Novalidate(flag bool) vecty.Applyer {
+	return applyAttr("novalidate", flag)
+}
+
+// Open specifies that the details should be visible (open) to the user
+//
+// <details>, <dialog>
+func Open(flag bool) vecty.Applyer {
+	return applyAttr("open", flag)
+}
+
+// Optimum specifies what value is the optimal value for the gauge
+//
+// <meter>
+func Optimum(value int64) vecty.Applyer {
+	return applyAttr("optimum", value)
+}
+
+// Placeholder specifies a short hint that describes the expected value of the element
+//
+// <input>, <textarea>
+func Placeholder(value string) vecty.Applyer {
+	return applyAttr("placeholder", value)
+}
+
+// Poster specifies an image to be shown while the video is downloading, or until the user hits the play button, it panics when the value is not valid, see PosterE.
+// User input should go through ParseURL
+//
+// <video>
+func Poster(value URL) vecty.Applyer {
+	applyer, err := PosterE(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// PosterE is Poster that returns an error instead of panicking
+//
+// <video>
+func PosterE(value URL) (vecty.Applyer, error) {
+	if err := value.check(); err != nil {
+		return nil, err
+	}
+
+	return applyAttr("poster", value.String()), nil
+}
+
+type PreloadCase string
+
+const (
+	PreloadCaseAuto     PreloadCase = "auto"
+	PreloadCaseMetadata PreloadCase = "metadata"
+	PreloadCaseNone     PreloadCase = "none"
+)
+
+// PreloadCaseValues returns the constants of PreloadCase, the deprecated ones are left out
+func PreloadCaseValues() []PreloadCase {
+	return []PreloadCase{PreloadCaseAuto, PreloadCaseMetadata, PreloadCaseNone}
+}
+
+// ParsePreloadCase returns the constant that matches the value ignoring case
+func ParsePreloadCase(value string) (PreloadCase, error) {
+	for _, c := range PreloadCaseValues() {
+		if strings.EqualFold(string(c), value) {
+			return c, nil
+		}
+	}
+
+	c := PreloadCase(value)
+	if err := c.check(); err != nil {
+		return "", err
+	}
+
+	return c, nil
+}
+
+// Valid reports whether the value is one of the constants
+func (c PreloadCase) Valid() bool {
+	switch c {
+	case PreloadCaseAuto, PreloadCaseMetadata, PreloadCaseNone:
+		return true
+	}
+
+	return false
+}
+
+func (c PreloadCase) String() string {
+	return string(c)
+}
+
+func (c PreloadCase) check() error {
+	if c.Valid() {
+		return nil
+	}
+
+	return &ValueError{Attr: "preload", Value: string(c), Reason: "expected one of auto, metadata, none"}
+}
+
+// Preload specifies if and how the author thinks the audio/video should be loaded when the page loads, it panics when the value is not valid, see PreloadE
+//
+// <audio>, <video>
+func Preload(c PreloadCase) vecty.Applyer {
+	applyer, err := PreloadE(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// PreloadE is Preload that returns an error instead of panicking
+//
+// <audio>, <video>
+func PreloadE(c PreloadCase) (vecty.Applyer, error) {
+	if err := c.check(); err != nil {
+		return nil, err
+	}
+
+	return applyAttr("preload", c.String()), nil
+}
+
+// Readonly specifies that the element is read-only
+//
+// <input>, <textarea>
+func Readonly(flag bool) vecty.Applyer {
+	return applyAttr("readonly", flag)
+}
+
+type ReferrerPolicyCase string
+
+const (
+	ReferrerPolicyCaseNoReferrer                  ReferrerPolicyCase = "no-referrer"
+	ReferrerPolicyCaseNoReferrerWhenDowngrade     ReferrerPolicyCase = "no-referrer-when-downgrade"
+	ReferrerPolicyCaseSameOrigin                  ReferrerPolicyCase = "same-origin"
+	ReferrerPolicyCaseOrigin                      ReferrerPolicyCase = "origin"
+	ReferrerPolicyCaseStrictOrigin                ReferrerPolicyCase = "strict-origin"
+	ReferrerPolicyCaseOriginWhenCrossOrigin       ReferrerPolicyCase = "origin-when-cross-origin"
+	ReferrerPolicyCaseStrictOriginWhenCrossOrigin ReferrerPolicyCase = "strict-origin-when-cross-origin"
+	ReferrerPolicyCaseUnsafeURL                   ReferrerPolicyCase = "unsafe-url"
+)
+
+// ReferrerPolicyCaseValues returns the constants of ReferrerPolicyCase, the deprecated ones are left out
+func ReferrerPolicyCaseValues() []ReferrerPolicyCase {
+	return []ReferrerPolicyCase{ReferrerPolicyCaseNoReferrer, ReferrerPolicyCaseNoReferrerWhenDowngrade, ReferrerPolicyCaseSameOrigin, ReferrerPolicyCaseOrigin, ReferrerPolicyCaseStrictOrigin, ReferrerPolicyCaseOriginWhenCrossOrigin, ReferrerPolicyCaseStrictOriginWhenCrossOrigin, ReferrerPolicyCaseUnsafeURL}
+}
+
+// ParseReferrerPolicyCase returns the constant that matches the value ignoring case
+func ParseReferrerPolicyCase(value string) (ReferrerPolicyCase, error) {
+	for _, c := range ReferrerPolicyCaseValues() {
+		if strings.EqualFold(string(c), value) {
+			return c, nil
+		}
+	}
+
+	c := ReferrerPolicyCase(value)
+	if err := c.check(); err != nil {
+		return "", err
+	}
+
+	return c, nil
+}
+
+// Valid reports whether the value is one of the constants
+func (c ReferrerPolicyCase) Valid() bool {
+	switch c {
+	case ReferrerPolicyCaseNoReferrer, ReferrerPolicyCaseNoReferrerWhenDowngrade, ReferrerPolicyCaseSameOrigin, ReferrerPolicyCaseOrigin, ReferrerPolicyCaseStrictOrigin, ReferrerPolicyCaseOriginWhenCrossOrigin, ReferrerPolicyCaseStrictOriginWhenCrossOrigin, ReferrerPolicyCaseUnsafeURL:
+		return true
+	}
+
+	return false
+}
+
+func (c ReferrerPolicyCase) String() string {
+	return string(c)
+}
+
+func (c ReferrerPolicyCase) check() error {
+	if c.Valid() {
+		return nil
+	}
+
+	return &ValueError{Attr: "referrerpolicy", Value: string(c), Reason: "expected one of no-referrer, no-referrer-when-downgrade, same-origin, origin, strict-origin, origin-when-cross-origin, strict-origin-when-cross-origin, unsafe-url"}
+}
+
+// ReferrerPolicy specifies which referrer information to send when fetching the resource or following the link, it panics when the value is not valid, see ReferrerPolicyE
+//
+// <a>, <area>, <iframe>, <img>, <link>, <script>
+func ReferrerPolicy(c ReferrerPolicyCase) vecty.Applyer {
+	applyer, err := ReferrerPolicyE(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// ReferrerPolicyE is ReferrerPolicy that returns an error instead of panicking
+//
+// <a>, <area>, <iframe>, <img>, <link>, <script>
+func ReferrerPolicyE(c ReferrerPolicyCase) (vecty.Applyer, error) {
+	if err := c.check(); err != nil {
+		return nil, err
+	}
+
+	return applyAttr("referrerpolicy", c.String()), nil
+}
+
+// Required specifies that the element must be filled out before submitting the form
+//
+// <input>, <select>, <textarea>
+func Required(flag bool) vecty.Applyer {
+	return applyAttr("required", flag)
+}
+
+// Reversed specifies that the list order should be descending (9,8,7...)
+//
+// <ol>
+func Reversed(flag bool) vecty.Applyer {
+	return applyAttr("reversed", flag)
+}
+
+// Rows specifies the visible number of lines in a text area
+//
+// <textarea>
+func Rows(value uint64) vecty.Applyer {
+	return applyAttr("rows", value)
+}
+
+// RowSpan specifies the number of rows a table cell should span
+//
+// <td>, <th>
+func RowSpan(value uint64) vecty.Applyer {
+	return applyAttr("rowspan", value)
+}
+
+// Sandbox enables an extra set of restrictions for the content in an <iframe>
+//
+// <iframe>
+func Sandbox(flag bool) vecty.Applyer {
+	return applyAttr("sandbox", flag)
+}
+
+type ScopeCase string
+
+const (
+	ScopeCaseCol      ScopeCase = "col"
+	ScopeCaseRow      ScopeCase = "row"
+	ScopeCaseColGroup ScopeCase = "colgroup"
+	ScopeCaseRowGroup ScopeCase = "rowgroup"
+)
+
+// ScopeCaseValues returns the constants of ScopeCase, the deprecated ones are left out
+func ScopeCaseValues() []ScopeCase {
+	return []ScopeCase{ScopeCaseCol, ScopeCaseRow, ScopeCaseColGroup, ScopeCaseRowGroup}
+}
+
+// ParseScopeCase returns the constant that matches the value ignoring case
+func ParseScopeCase(value string) (ScopeCase, error) {
+	for _, c := range ScopeCaseValues() {
+		if strings.EqualFold(string(c), value) {
+			return c, nil
+		}
+	}
+
+	c := ScopeCase(value)
+	if err := c.check(); err != nil {
+		return "", err
+	}
+
+	return c, nil
+}
+
+// Valid reports whether the value is one of the constants
+func (c ScopeCase) Valid() bool {
+	switch c {
+	case ScopeCaseCol, ScopeCaseRow, ScopeCaseColGroup, ScopeCaseRowGroup:
+		return true
+	}
+
+	return false
+}
+
+func (c ScopeCase) String() string {
+	return string(c)
+}
+
+func (c ScopeCase) check() error {
+	if c.Valid() {
+		return nil
+	}
+
+	return &ValueError{Attr: "scope", Value: string(c), Reason: "expected one of col, row, colgroup, rowgroup"}
+}
+
+// Scope specifies whether a header cell is a header for a column, row, or group of columns or rows, it panics when the value is not valid, see ScopeE
+//
+// <th>
+func Scope(c ScopeCase) vecty.Applyer {
+	applyer, err := ScopeE(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// ScopeE is Scope that returns an error instead of panicking
+//
+// <th>
+func ScopeE(c ScopeCase) (vecty.Applyer, error) {
+	if err := c.check(); err != nil {
+		return nil, err
+	}
+
+	return applyAttr("scope", c.String()), nil
+}
+
+// ScriptTypeCase is the type of <script>, a MIME type other than JavaScript makes it a data block
+type ScriptTypeCase string
+
+const (
+	ScriptTypeCaseJavaScript       ScriptTypeCase = "text/javascript"
+	ScriptTypeCaseModule           ScriptTypeCase = "module"
+	ScriptTypeCaseImportMap        ScriptTypeCase = "importmap"
+	ScriptTypeCaseSpeculationRules ScriptTypeCase = "speculationrules"
+)
+
+// ScriptTypeCaseValues returns the constants of ScriptTypeCase, the deprecated ones are left out
+func ScriptTypeCaseValues() []ScriptTypeCase {
+	return []ScriptTypeCase{ScriptTypeCaseJavaScript, ScriptTypeCaseModule, ScriptTypeCaseImportMap, ScriptTypeCaseSpeculationRules}
+}
+
+// ParseScriptTypeCase returns the constant that matches the value ignoring case, or the value when it is valid
+func ParseScriptTypeCase(value string) (ScriptTypeCase, error) {
+	for _, c := range ScriptTypeCaseValues() {
+		if strings.EqualFold(string(c), value) {
+			return c, nil
+		}
+	}
+
+	c := ScriptTypeCase(value)
+	if err := c.check(); err != nil {
+		return "", err
+	}
+
+	return c, nil
+}
+
+// Valid reports whether the value is one of the constants or a MIME type
+func (c ScriptTypeCase) Valid() bool {
+	switch c {
+	case ScriptTypeCaseJavaScript, ScriptTypeCaseModule, ScriptTypeCaseImportMap, ScriptTypeCaseSpeculationRules:
+		return true
+	}
+
+	return validMIME(string(c))
+}
+
+func (c ScriptTypeCase) String() string {
+	return string(c)
+}
+
+func (c ScriptTypeCase) check() error {
+	if c.Valid() {
+		return nil
+	}
+
+	return &ValueError{Attr: "type", Value: string(c), Reason: "expected one of text/javascript, module, importmap, speculationrules, a MIME type"}
+}
+
+// ScriptType specifies whether the script is a classic script, a module, an import map, speculation rules or a data block, it panics when the value is not valid, see ScriptTypeE
+//
+// <script>
+func ScriptType(c ScriptTypeCase) vecty.Applyer {
+	applyer, err := ScriptTypeE(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// ScriptTypeE is ScriptType that returns an error instead of panicking
+//
+// <script>
+func ScriptTypeE(c ScriptTypeCase) (vecty.Applyer, error) {
+	if err := c.check(); err != nil {
+		return nil, err
+	}
+
+	return applyAttr("type", c.String()), nil
+}
+
+// Selected specifies that an option should be pre-selected when the page loads
+//
+// <option>
+func Selected(flag bool) vecty.Applyer {
+	return applyAttr("selected", flag)
+}
+
+type ShapeCase string
+
+const (
+	ShapeCaseDefault ShapeCase = "default"
+	ShapeCaseRect    ShapeCase = "rect"
+	ShapeCaseCircle  ShapeCase = "circle"
+	ShapeCasePoly    ShapeCase = "poly"
+)
+
+// ShapeCaseValues returns the constants of ShapeCase, the deprecated ones are left out
+func ShapeCaseValues() []ShapeCase {
+	return []ShapeCase{ShapeCaseDefault, ShapeCaseRect, ShapeCaseCircle, ShapeCasePoly}
+}
+
+// ParseShapeCase returns the constant that matches the value ignoring case
+func ParseShapeCase(value string) (ShapeCase, error) {
+	for _, c := range ShapeCaseValues() {
+		if strings.EqualFold(string(c), value) {
+			return c, nil
+		}
+	}
+
+	c := ShapeCase(value)
+	if err := c.check(); err != nil {
+		return "", err
+	}
+
+	return c, nil
+}
+
+// Valid reports whether the value is one of the constants
+func (c ShapeCase) Valid() bool {
+	switch c {
+	case ShapeCaseDefault, ShapeCaseRect, ShapeCaseCircle, ShapeCasePoly:
+		return true
+	}
+
+	return false
+}
+
+func (c ShapeCase) String() string {
+	return string(c)
+}
+
+func (c ShapeCase) check() error {
+	if c.Valid() {
+		return nil
+	}
+
+	return &ValueError{Attr: "shape", Value: string(c), Reason: "expected one of default, rect, circle, poly"}
+}
+
+// Shape specifies the shape of the area, it panics when the value is not valid, see ShapeE
+//
+// <area>
+func Shape(c ShapeCase) vecty.Applyer {
+	applyer, err := ShapeE(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// ShapeE is Shape that returns an error instead of panicking
+//
+// <area>
+func ShapeE(c ShapeCase) (vecty.Applyer, error) {
+	if err := c.check(); err != nil {
+		return nil, err
+	}
+
+	return applyAttr("shape", c.String()), nil
+}
+
+// Size specifies the width, in characters (for <input>) or specifies the number of visible options (for <select>)
+//
+// <input>, <select>
+func Size(value uint64) vecty.Applyer {
+	return applyAttr("size", value)
+}
+
+// Span specifies the number of columns to span
+//
+// <col>, <colgroup>
+func Span(value uint64) vecty.Applyer {
+	return applyAttr("span", value)
+}
+
+// SpellCheck specifies whether the element is to have its spelling and grammar checked or not
+//
+// Global Attributes
+func SpellCheck(flag bool) vecty.Applyer {
+	return applyAttr("spellcheck", flag)
+}
+
+// Src specifies the URL of the media file, it panics when the value is not valid, see SrcE.
+// User input should go through ParseURL
+//
+// <audio>, <embed>, <iframe>, <img>, <input>, <script>, <source>, <track>, <video>
+func Src(value URL) vecty.Applyer {
+	applyer, err := SrcE(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// SrcE is Src that returns an error instead of panicking
+//
+// <audio>, <embed>, <iframe>, <img>, <input>, <script>, <source>, <track>, <video>
+func SrcE(value URL) (vecty.Applyer, error) {
+	if err := value.check(); err != nil {
+		return nil, err
+	}
+
+	return applyAttr("src", value.String()), nil
+}
+
+// SrcLang specifies the language of the track text data (required if kind="subtitles")
+//
+// <track>
+func SrcLang(value string) vecty.Applyer {
+	return applyAttr("srclang", value)
+}
+
+// Start specifies the start value of an ordered list
+//
+// <ol>
+func Start(value int64) vecty.Applyer {
+	return applyAttr("start", value)
+}
+
+// TabIndex specifies the tabbing order of an element
+//
+// Global Attributes
+func TabIndex(value int64) vecty.Applyer {
+	return applyAttr("tabindex", value)
+}
+
+type TargetCase string
+
+const (
+	TargetCaseBlank  TargetCase = "_blank"
+	TargetCaseSelf   TargetCase = "_self"
+	TargetCaseParent TargetCase = "_parent"
+	TargetCaseTop    TargetCase = "_top"
+)
+
+// TargetCaseValues returns the constants of TargetCase, the deprecated ones are left out
+func TargetCaseValues() []TargetCase {
+	return []TargetCase{TargetCaseBlank, TargetCaseSelf, TargetCaseParent, TargetCaseTop}
+}
+
+// ParseTargetCase returns the constant that matches the value ignoring case, or the value when it is valid
+func ParseTargetCase(value string) (TargetCase, error) {
+	for _, c := range TargetCaseValues() {
+		if strings.EqualFold(string(c), value) {
+			return c, nil
+		}
+	}
+
+	c := TargetCase(value)
+	if err := c.check(); err != nil {
+		return "", err
+	}
+
+	return c, nil
+}
+
+// Valid reports whether the target attribute allows the value
+func (c TargetCase) Valid() bool {
+	return checkValue("target", string(c)) == nil
+}
+
+func (c TargetCase) String() string {
+	return string(c)
+}
+
+func (c TargetCase) check() error {
+	return checkValue("target", string(c))
+}
+
+// Target specifies the target for where to open the linked document or where to submit the form, it panics when the value is not valid, see TargetE
+//
+// <a>, <area>, <base>, <form>
+func Target(c TargetCase) vecty.Applyer {
+	applyer, err := TargetE(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// TargetE is Target that returns an error instead of panicking
+//
+// <a>, <area>, <base>, <form>
+func TargetE(c TargetCase) (vecty.Applyer, error) {
+	if err := c.check(); err != nil {
+		return nil, err
+	}
+
+	return applyAttr("target", c.String()), nil
+}
+
+// Title specifies extra information about an element
+//
+// Global Attributes
+func Title(value string) vecty.Applyer {
+	return applyAttr("title", value)
+}
+
+// Translate specifies whether the content of an element should be translated or not
+//
+// Global Attributes
+func Translate(flag bool) vecty.Applyer {
+	return applyAttr("translate", flag)
+}
+
+// TypeCase is a value of the type attribute of any element
+//
+// Deprecated: use InputTypeCase, ButtonTypeCase, ScriptTypeCase or MIME
+type TypeCase string
+
+const (
+	TypeCaseButton        TypeCase = "button"
+	TypeCaseCheckbox      TypeCase = "checkbox"
+	TypeCaseColor         TypeCase = "color"
+	TypeCaseDate          TypeCase = "date"
+	TypeCaseDatetimeLocal TypeCase = "datetime-local"
+	TypeCaseEmail         TypeCase = "email"
+	TypeCaseFile          TypeCase = "file"
+	TypeCaseHidden        TypeCase = "hidden"
+	TypeCaseImage         TypeCase = "image"
+	TypeCaseMonth         TypeCase = "month"
+	TypeCaseNumber        TypeCase = "number"
+	TypeCasePassword      TypeCase = "password"
+	TypeCaseRadio         TypeCase = "radio"
+	TypeCaseRange         TypeCase = "range"
+	TypeCaseReset         TypeCase = "reset"
+	TypeCaseSearch        TypeCase = "search"
+	TypeCaseSubmit        TypeCase = "submit"
+	TypeCaseTel           TypeCase = "tel"
+	TypeCaseText          TypeCase = "text"
+	TypeCaseTime          TypeCase = "time"
+	TypeCaseURL           TypeCase = "url"
+	TypeCaseWeek          TypeCase = "week"
+	TypeCaseModule        TypeCase = "module"
+
+	// Deprecated: not a type of any element
+	TypeCaseDatetime TypeCase = "datetime"
+	// Deprecated: not a type of any element
+	TypeCaseMin TypeCase = "min"
+	// Deprecated: not a type of any element
+	TypeCaseMax TypeCase = "max"
+	// Deprecated: not a type of any element
+	TypeCaseValue TypeCase = "value"
+	// Deprecated: not a type of any element
+	TypeCaseStep TypeCase = "step"
+	// Deprecated: not a type of any element
+	TypeCaseList TypeCase = "list"
+	// Deprecated: not a type of any element
+	TypeCaseContext TypeCase = "context"
+	// Deprecated: not a type of any element
+	TypeCaseToolbar TypeCase = "toolbar"
+)
+
+// TypeCaseValues returns the constants of TypeCase, the deprecated ones are left out
+func TypeCaseValues() []TypeCase {
+	return []TypeCase{TypeCaseButton, TypeCaseCheckbox, TypeCaseColor, TypeCaseDate, TypeCaseDatetimeLocal, TypeCaseEmail, TypeCaseFile, TypeCaseHidden, TypeCaseImage, TypeCaseMonth, TypeCaseNumber, TypeCasePassword, TypeCaseRadio, TypeCaseRange, TypeCaseReset, TypeCaseSearch, TypeCaseSubmit, TypeCaseTel, TypeCaseText, TypeCaseTime, TypeCaseURL, TypeCaseWeek, TypeCaseModule}
+}
+
+// ParseTypeCase returns the constant that matches the value ignoring case, or the value when it is valid
+func ParseTypeCase(value string) (TypeCase, error) {
+	for _, c := range TypeCaseValues() {
+		if strings.EqualFold(string(c), value) {
+			return c, nil
+		}
+	}
+
+	c := TypeCase(value)
+	if err := c.check(); err != nil {
+		return "", err
+	}
+
+	return c, nil
+}
+
+// Valid reports whether the type attribute allows the value
+func (c TypeCase) Valid() bool {
+	return checkValue("type", string(c)) == nil
+}
+
+func (c TypeCase) String() string {
+	return string(c)
+}
+
+func (c TypeCase) check() error {
+	// the deprecated constants stay valid, so the code that uses them doesn't start to panic
+	switch c {
+	case TypeCaseDatetime, TypeCaseMin, TypeCaseMax, TypeCaseValue, TypeCaseStep, TypeCaseList, TypeCaseContext, TypeCaseToolbar:
+		return nil
+	}
+
+	return checkValue("type", string(c))
+}
+
+// Type specifies the type of element, it panics when the value is not valid, see TypeE
+//
+// <a>, <button>, <embed>, <input>, <link>, <object>, <ol>, <script>, <source>, <style>
+//
+// Deprecated: use InputType, ButtonType, ScriptType or MIMEType, they check the value for the element
+func Type(c TypeCase) vecty.Applyer {
+	applyer, err := TypeE(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// TypeE is Type that returns an error instead of panicking
+//
+// <a>, <button>, <embed>, <input>, <link>, <object>, <ol>, <script>, <source>, <style>
+//
+// Deprecated: use InputType, ButtonType, ScriptType or MIMEType, they check the value for the element
+func TypeE(c TypeCase) (vecty.Applyer, error) {
+	if err := c.check(); err != nil {
+		return nil, err
+	}
+
+	return applyAttr("type", c.String()), nil
+}
+
+// Width specifies the width of the element
+//
+// <canvas>, <embed>, <iframe>, <img>, <input>, <object>, <source>, <video>
+func Width(value uint64) vecty.Applyer {
+	return applyAttr("width", value)
+}
+
+type WrapCase string
+
+const (
+	WrapCaseSoft WrapCase = "soft"
+	WrapCaseHard WrapCase = "hard"
+)
+
+// WrapCaseValues returns the constants of WrapCase, the deprecated ones are left out
+func WrapCaseValues() []WrapCase {
+	return []WrapCase{WrapCaseSoft, WrapCaseHard}
+}
+
+// ParseWrapCase returns the constant that matches the value ignoring case
+func ParseWrapCase(value string) (WrapCase, error) {
+	for _, c := range WrapCaseValues() {
+		if strings.EqualFold(string(c), value) {
+			return c, nil
+		}
+	}
+
+	c := WrapCase(value)
+	if err := c.check(); err != nil {
+		return "", err
+	}
+
+	return c, nil
+}
+
+// Valid reports whether the value is one of the constants
+func (c WrapCase) Valid() bool {
+	switch c {
+	case WrapCaseSoft, WrapCaseHard:
+		return true
+	}
+
+	return false
+}
+
+func (c WrapCase) String() string {
+	return string(c)
+}
+
+func (c WrapCase) check() error {
+	if c.Valid() {
+		return nil
+	}
+
+	return &ValueError{Attr: "wrap", Value: string(c), Reason: "expected one of soft, hard"}
+}
+
+// Wrap specifies how the text in a text area is to be wrapped when submitted in a form., it panics when the value is not valid, see WrapE
+//
+// <textarea>
+func Wrap(c WrapCase) vecty.Applyer {
+	applyer, err := WrapE(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return applyer
+}
+
+// WrapE is Wrap that returns an error instead of panicking
+//
+// <textarea>
+func WrapE(c WrapCase) (vecty.Applyer, error) {
+	if err := c.check(); err != nil {
+		return nil, err
+	}
+
+	return applyAttr("wrap", c.String()), nil
+}
+
+// RelToken is a link type of the rel attribute, Rel checks that it applies to the element
+type RelToken string
+
+const (
+	RelTokenAlternate      RelToken = "alternate"
+	RelTokenAuthor         RelToken = "author"
+	RelTokenBookmark       RelToken = "bookmark"
+	RelTokenCanonical      RelToken = "canonical"
+	RelTokenDNSPrefetch    RelToken = "dns-prefetch"
+	RelTokenExternal       RelToken = "external"
+	RelTokenHelp           RelToken = "help"
+	RelTokenIcon           RelToken = "icon"
+	RelTokenLicense        RelToken = "license"
+	RelTokenManifest       RelToken = "manifest"
+	RelTokenMe             RelToken = "me"
+	RelTokenModulePreload  RelToken = "modulepreload"
+	RelTokenNext           RelToken = "next"
+	RelTokenNofollow       RelToken = "nofollow"
+	RelTokenNoOpener       RelToken = "noopener"
+	RelTokenNoReferrer     RelToken = "noreferrer"
+	RelTokenOpener         RelToken = "opener"
+	RelTokenPingback       RelToken = "pingback"
+	RelTokenPreconnect     RelToken = "preconnect"
+	RelTokenPrefetch       RelToken = "prefetch"
+	RelTokenPreload        RelToken = "preload"
+	RelTokenPrev           RelToken = "prev"
+	RelTokenPrivacyPolicy  RelToken = "privacy-policy"
+	RelTokenSearch         RelToken = "search"
+	RelTokenSponsored      RelToken = "sponsored"
+	RelTokenStylesheet     RelToken = "stylesheet"
+	RelTokenTag            RelToken = "tag"
+	RelTokenTermsOfService RelToken = "terms-of-service"
+	RelTokenUGC            RelToken = "ugc"
+
+	// Deprecated: use RelTokenAlternate
+	RelCaseAlternate = RelTokenAlternate
+	// Deprecated: use RelTokenAuthor
+	RelCaseAuthor = RelTokenAuthor
+	// Deprecated: use RelTokenBookmark
+	RelCaseBookmark = RelTokenBookmark
+	// Deprecated: use RelTokenExternal
+	RelCaseExternal = RelTokenExternal
+	// Deprecated: use RelTokenHelp
+	RelCaseHelp = RelTokenHelp
+	// Deprecated: use RelTokenLicense
+	RelCaseLicense = RelTokenLicense
+	// Deprecated: use RelTokenNext
+	RelCaseNext = RelTokenNext
+	// Deprecated: use RelTokenNofollow
+	RelCaseNofollow = RelTokenNofollow
+	// Deprecated: use RelTokenNoOpener
+	RelCaseNoOpener = RelTokenNoOpener
+	// Deprecated: use RelTokenNoReferrer
+	RelCaseNoReferrer = RelTokenNoReferrer
+	// Deprecated: use RelTokenPrev
+	RelCasePrev = RelTokenPrev
+	// Deprecated: use RelTokenSearch
+	RelCaseSearch = RelTokenSearch
+	// Deprecated: use RelTokenTag
+	RelCaseTag = RelTokenTag
+	// Deprecated: use RelTokenLicense
+	RelCaseLicence = RelTokenLicense
+)
+
+// RelTokenValues returns the constants of RelToken, the deprecated ones are left out
+func RelTokenValues() []RelToken {
+	return []RelToken{RelTokenAlternate, RelTokenAuthor, RelTokenBookmark, RelTokenCanonical, RelTokenDNSPrefetch, RelTokenExternal, RelTokenHelp, RelTokenIcon, RelTokenLicense, RelTokenManifest, RelTokenMe, RelTokenModulePreload, RelTokenNext, RelTokenNofollow, RelTokenNoOpener, RelTokenNoReferrer, RelTokenOpener, RelTokenPingback, RelTokenPreconnect, RelTokenPrefetch, RelTokenPreload, RelTokenPrev, RelTokenPrivacyPolicy, RelTokenSearch, RelTokenSponsored, RelTokenStylesheet, RelTokenTag, RelTokenTermsOfService, RelTokenUGC}
+}
+
+// ParseRelToken returns the constant that matches the value ignoring case
+func ParseRelToken(value string) (RelToken, error) {
+	for _, c := range RelTokenValues() {
+		if strings.EqualFold(string(c), value) {
+			return c, nil
+		}
+	}
+
+	c := RelToken(value)
+	if err := c.check(); err != nil {
+		return "", err
+	}
+
+	return c, nil
+}
+
+// Valid reports whether the value is one of the constants
+func (c RelToken) Valid() bool {
+	switch c {
+	case RelTokenAlternate, RelTokenAuthor, RelTokenBookmark, RelTokenCanonical, RelTokenDNSPrefetch, RelTokenExternal, RelTokenHelp, RelTokenIcon, RelTokenLicense, RelTokenManifest, RelTokenMe, RelTokenModulePreload, RelTokenNext, RelTokenNofollow, RelTokenNoOpener, RelTokenNoReferrer, RelTokenOpener, RelTokenPingback, RelTokenPreconnect, RelTokenPrefetch, RelTokenPreload, RelTokenPrev, RelTokenPrivacyPolicy, RelTokenSearch, RelTokenSponsored, RelTokenStylesheet, RelTokenTag, RelTokenTermsOfService, RelTokenUGC:
+		return true
+	}
+
+	return false
+}
+
+func (c RelToken) String() string {
+	return string(c)
+}
+
+func (c RelToken) check() error {
+	if c.Valid() {
+		return nil
+	}
+
+	return &ValueError{Attr: "rel", Value: string(c), Reason: "expected one of alternate, author, bookmark, canonical, dns-prefetch, external, help, icon, license, manifest, me, modulepreload, next, nofollow, noopener, noreferrer, opener, pingback, preconnect, prefetch, preload, prev, privacy-policy, search, sponsored, stylesheet, tag, terms-of-service, ugc"}
+}
+
+// AutofillFieldCase is the autofill field name, the last token of the autocomplete attribute before webauthn
+type AutofillFieldCase string
+
+const (
+	AutofillFieldCaseName                AutofillFieldCase = "name"
+	AutofillFieldCaseHonorificPrefix     AutofillFieldCase = "honorific-prefix"
+	AutofillFieldCaseGivenName           AutofillFieldCase = "given-name"
+	AutofillFieldCaseAdditionalName      AutofillFieldCase = "additional-name"
+	AutofillFieldCaseFamilyName          AutofillFieldCase = "family-name"
+	AutofillFieldCaseHonorificSuffix     AutofillFieldCase = "honorific-suffix"
+	AutofillFieldCaseNickname            AutofillFieldCase = "nickname"
+	AutofillFieldCaseUsername            AutofillFieldCase = "username"
+	AutofillFieldCaseNewPassword         AutofillFieldCase = "new-password"
+	AutofillFieldCaseCurrentPassword     AutofillFieldCase = "current-password"
+	AutofillFieldCaseOneTimeCode         AutofillFieldCase = "one-time-code"
+	AutofillFieldCaseOrganizationTitle   AutofillFieldCase = "organization-title"
+	AutofillFieldCaseOrganization        AutofillFieldCase = "organization"
+	AutofillFieldCaseStreetAddress       AutofillFieldCase = "street-address"
+	AutofillFieldCaseAddressLine1        AutofillFieldCase = "address-line1"
+	AutofillFieldCaseAddressLine2        AutofillFieldCase = "address-line2"
+	AutofillFieldCaseAddressLine3        AutofillFieldCase = "address-line3"
+	AutofillFieldCaseAddressLevel4       AutofillFieldCase = "address-level4"
+	AutofillFieldCaseAddressLevel3       AutofillFieldCase = "address-level3"
+	AutofillFieldCaseAddressLevel2       AutofillFieldCase = "address-level2"
+	AutofillFieldCaseAddressLevel1       AutofillFieldCase = "address-level1"
+	AutofillFieldCaseCountry             AutofillFieldCase = "country"
+	AutofillFieldCaseCountryName         AutofillFieldCase = "country-name"
+	AutofillFieldCasePostalCode          AutofillFieldCase = "postal-code"
+	AutofillFieldCaseCCName              AutofillFieldCase = "cc-name"
+	AutofillFieldCaseCCGivenName         AutofillFieldCase = "cc-given-name"
+	AutofillFieldCaseCCAdditionalName    AutofillFieldCase = "cc-additional-name"
+	AutofillFieldCaseCCFamilyName        AutofillFieldCase = "cc-family-name"
+	AutofillFieldCaseCCNumber            AutofillFieldCase = "cc-number"
+	AutofillFieldCaseCCExp               AutofillFieldCase = "cc-exp"
+	AutofillFieldCaseCCExpMonth          AutofillFieldCase = "cc-exp-month"
+	AutofillFieldCaseCCExpYear           AutofillFieldCase = "cc-exp-year"
+	AutofillFieldCaseCCCSC               AutofillFieldCase = "cc-csc"
+	AutofillFieldCaseCCType              AutofillFieldCase = "cc-type"
+	AutofillFieldCaseTransactionCurrency AutofillFieldCase = "transaction-currency"
+	AutofillFieldCaseTransactionAmount   AutofillFieldCase = "transaction-amount"
+	AutofillFieldCaseLanguage            AutofillFieldCase = "language"
+	AutofillFieldCaseBday                AutofillFieldCase = "bday"
+	AutofillFieldCaseBdayDay             AutofillFieldCase = "bday-day"
+	AutofillFieldCaseBdayMonth           AutofillFieldCase = "bday-month"
+	AutofillFieldCaseBdayYear            AutofillFieldCase = "bday-year"
+	AutofillFieldCaseSex                 AutofillFieldCase = "sex"
+	AutofillFieldCaseURL                 AutofillFieldCase = "url"
+	AutofillFieldCasePhoto               AutofillFieldCase = "photo"
+	AutofillFieldCaseTel                 AutofillFieldCase = "tel"
+	AutofillFieldCaseTelCountryCode      AutofillFieldCase = "tel-country-code"
+	AutofillFieldCaseTelNational         AutofillFieldCase = "tel-national"
+	AutofillFieldCaseTelAreaCode         AutofillFieldCase = "tel-area-code"
+	AutofillFieldCaseTelLocal            AutofillFieldCase = "tel-local"
+	AutofillFieldCaseTelLocalPrefix      AutofillFieldCase = "tel-local-prefix"
+	AutofillFieldCaseTelLocalSuffix      AutofillFieldCase = "tel-local-suffix"
+	AutofillFieldCaseTelExtension        AutofillFieldCase = "tel-extension"
+	AutofillFieldCaseEmail               AutofillFieldCase = "email"
+	AutofillFieldCaseIMPP                AutofillFieldCase = "impp"
+)
+
+// AutofillFieldCaseValues returns the constants of AutofillFieldCase, the deprecated ones are left out
+func AutofillFieldCaseValues() []AutofillFieldCase {
+	return []AutofillFieldCase{AutofillFieldCaseName, AutofillFieldCaseHonorificPrefix, AutofillFieldCaseGivenName, AutofillFieldCaseAdditionalName, AutofillFieldCaseFamilyName, AutofillFieldCaseHonorificSuffix, AutofillFieldCaseNickname, AutofillFieldCaseUsername, AutofillFieldCaseNewPassword, AutofillFieldCaseCurrentPassword, AutofillFieldCaseOneTimeCode, AutofillFieldCaseOrganizationTitle, AutofillFieldCaseOrganization, AutofillFieldCaseStreetAddress, AutofillFieldCaseAddressLine1, AutofillFieldCaseAddressLine2, AutofillFieldCaseAddressLine3, AutofillFieldCaseAddressLevel4, AutofillFieldCaseAddressLevel3, AutofillFieldCaseAddressLevel2, AutofillFieldCaseAddressLevel1, AutofillFieldCaseCountry, AutofillFieldCaseCountryName, AutofillFieldCasePostalCode, AutofillFieldCaseCCName, AutofillFieldCaseCCGivenName, AutofillFieldCaseCCAdditionalName, AutofillFieldCaseCCFamilyName, AutofillFieldCaseCCNumber, AutofillFieldCaseCCExp, AutofillFieldCaseCCExpMonth, AutofillFieldCaseCCExpYear, AutofillFieldCaseCCCSC, AutofillFieldCaseCCType, AutofillFieldCaseTransactionCurrency, AutofillFieldCaseTransactionAmount, AutofillFieldCaseLanguage, AutofillFieldCaseBday, AutofillFieldCaseBdayDay, AutofillFieldCaseBdayMonth, AutofillFieldCaseBdayYear, AutofillFieldCaseSex, AutofillFieldCaseURL, AutofillFieldCasePhoto, AutofillFieldCaseTel, AutofillFieldCaseTelCountryCode, AutofillFieldCaseTelNational, AutofillFieldCaseTelAreaCode, AutofillFieldCaseTelLocal, AutofillFieldCaseTelLocalPrefix, AutofillFieldCaseTelLocalSuffix, AutofillFieldCaseTelExtension, AutofillFieldCaseEmail, AutofillFieldCaseIMPP}
+}
+
+// ParseAutofillFieldCase returns the constant that matches the value ignoring case
+func ParseAutofillFieldCase(value string) (AutofillFieldCase, error) {
+	for _, c := range AutofillFieldCaseValues() {
+		if strings.EqualFold(string(c), value) {
+			return c, nil
+		}
+	}
+
+	c := AutofillFieldCase(value)
+	if err := c.check(); err != nil {
+		return "", err
+	}
+
+	return c, nil
+}
+
+// Valid reports whether the value is one of the constants
+func (c AutofillFieldCase) Valid() bool {
+	switch c {
+	case AutofillFieldCaseName, AutofillFieldCaseHonorificPrefix, AutofillFieldCaseGivenName, AutofillFieldCaseAdditionalName, AutofillFieldCaseFamilyName, AutofillFieldCaseHonorificSuffix, AutofillFieldCaseNickname, AutofillFieldCaseUsername, AutofillFieldCaseNewPassword, AutofillFieldCaseCurrentPassword, AutofillFieldCaseOneTimeCode, AutofillFieldCaseOrganizationTitle, AutofillFieldCaseOrganization, AutofillFieldCaseStreetAddress, AutofillFieldCaseAddressLine1, AutofillFieldCaseAddressLine2, AutofillFieldCaseAddressLine3, AutofillFieldCaseAddressLevel4, AutofillFieldCaseAddressLevel3, AutofillFieldCaseAddressLevel2, AutofillFieldCaseAddressLevel1, AutofillFieldCaseCountry, AutofillFieldCaseCountryName, AutofillFieldCasePostalCode, AutofillFieldCaseCCName, AutofillFieldCaseCCGivenName, AutofillFieldCaseCCAdditionalName, AutofillFieldCaseCCFamilyName, AutofillFieldCaseCCNumber, AutofillFieldCaseCCExp, AutofillFieldCaseCCExpMonth, AutofillFieldCaseCCExpYear, AutofillFieldCaseCCCSC, AutofillFieldCaseCCType, AutofillFieldCaseTransactionCurrency, AutofillFieldCaseTransactionAmount, AutofillFieldCaseLanguage, AutofillFieldCaseBday, AutofillFieldCaseBdayDay, AutofillFieldCaseBdayMonth, AutofillFieldCaseBdayYear, AutofillFieldCaseSex, AutofillFieldCaseURL, AutofillFieldCasePhoto, AutofillFieldCaseTel, AutofillFieldCaseTelCountryCode, AutofillFieldCaseTelNational, AutofillFieldCaseTelAreaCode, AutofillFieldCaseTelLocal, AutofillFieldCaseTelLocalPrefix, AutofillFieldCaseTelLocalSuffix, AutofillFieldCaseTelExtension, AutofillFieldCaseEmail, AutofillFieldCaseIMPP:
+		return true
+	}
+
+	return false
+}
+
+func (c AutofillFieldCase) String() string {
+	return string(c)
+}
+
+func (c AutofillFieldCase) check() error {
+	if c.Valid() {
+		return nil
+	}
+
+	return &ValueError{Attr: "autocomplete", Value: string(c), Reason: "expected one of name, honorific-prefix, given-name, additional-name, family-name, honorific-suffix, nickname, username, new-password, current-password, one-time-code, organization-title, organization, street-address, address-line1, address-line2, address-line3, address-level4, address-level3, address-level2, address-level1, country, country-name, postal-code, cc-name, cc-given-name, cc-additional-name, cc-family-name, cc-number, cc-exp, cc-exp-month, cc-exp-year, cc-csc, cc-type, transaction-currency, transaction-amount, language, bday, bday-day, bday-month, bday-year, sex, url, photo, tel, tel-country-code, tel-national, tel-area-code, tel-local, tel-local-prefix, tel-local-suffix, tel-extension, email, impp"}
+}
+
+// AutofillAddressCase tells which address the field belongs to
+type AutofillAddressCase string
+
+const (
+	AutofillAddressCaseShipping AutofillAddressCase = "shipping"
+	AutofillAddressCaseBilling  AutofillAddressCase = "billing"
+)
+
+// AutofillAddressCaseValues returns the constants of AutofillAddressCase, the deprecated ones are left out
+func AutofillAddressCaseValues() []AutofillAddressCase {
+	return []AutofillAddressCase{AutofillAddressCaseShipping, AutofillAddressCaseBilling}
+}
+
+// ParseAutofillAddressCase returns the constant that matches the value ignoring case
+func ParseAutofillAddressCase(value string) (AutofillAddressCase, error) {
+	for _, c := range AutofillAddressCaseValues() {
+		if strings.EqualFold(string(c), value) {
+			return c, nil
+		}
+	}
+
+	c := AutofillAddressCase(value)
+	if err := c.check(); err != nil {
+		return "", err
+	}
+
+	return c, nil
+}
+
+// Valid reports whether the value is one of the constants
+func (c AutofillAddressCase) Valid() bool {
+	switch c {
+	case AutofillAddressCaseShipping, AutofillAddressCaseBilling:
+		return true
+	}
+
+	return false
+}
+
+func (c AutofillAddressCase) String() string {
+	return string(c)
+}
+
+func (c AutofillAddressCase) check() error {
+	if c.Valid() {
+		return nil
+	}
+
+	return &ValueError{Attr: "autocomplete", Value: string(c), Reason: "expected one of shipping, billing"}
+}
+
+// AutofillContactCase tells which kind of contact the field is
+type AutofillContactCase string
+
+const (
+	AutofillContactCaseHome   AutofillContactCase = "home"
+	AutofillContactCaseWork   AutofillContactCase = "work"
+	AutofillContactCaseMobile AutofillContactCase = "mobile"
+	AutofillContactCaseFax    AutofillContactCase = "fax"
+	AutofillContactCasePager  AutofillContactCase = "pager"
+)
+
+// AutofillContactCaseValues returns the constants of AutofillContactCase, the deprecated ones are left out
+func AutofillContactCaseValues() []AutofillContactCase {
+	return []AutofillContactCase{AutofillContactCaseHome, AutofillContactCaseWork, AutofillContactCaseMobile, AutofillContactCaseFax, AutofillContactCasePager}
+}
+
+// ParseAutofillContactCase returns the constant that matches the value ignoring case
+func ParseAutofillContactCase(value string) (AutofillContactCase, error) {
+	for _, c := range AutofillContactCaseValues() {
+		if strings.EqualFold(string(c), value) {
+			return c, nil
+		}
+	}
+
+	c := AutofillContactCase(value)
+	if err := c.check(); err != nil {
+		return "", err
+	}
+
+	return c, nil
+}
+
+// Valid reports whether the value is one of the constants
+func (c AutofillContactCase) Valid() bool {
+	switch c {
+	case AutofillContactCaseHome, AutofillContactCaseWork, AutofillContactCaseMobile, AutofillContactCaseFax, AutofillContactCasePager:
+		return true
+	}
+
+	return false
+}
+
+func (c AutofillContactCase) String() string {
+	return string(c)
+}
+
+func (c AutofillContactCase) check() error {
+	if c.Valid() {
+		return nil
+	}
+
+	return &ValueError{Attr: "autocomplete", Value: string(c), Reason: "expected one of home, work, mobile, fax, pager"}
+}
+
+// OrientationCase is the orientation media feature
+type OrientationCase string
+
+const (
+	OrientationCaseLandscape OrientationCase = "landscape"
+	OrientationCasePortrait  OrientationCase = "portrait"
+)
+
+// OrientationCaseValues returns the constants of OrientationCase, the deprecated ones are left out
+func OrientationCaseValues() []OrientationCase {
+	return []OrientationCase{OrientationCaseLandscape, OrientationCasePortrait}
+}
+
+// ParseOrientationCase returns the constant that matches the value ignoring case
+func ParseOrientationCase(value string) (OrientationCase, error) {
+	for _, c := range OrientationCaseValues() {
+		if strings.EqualFold(string(c), value) {
+			return c, nil
+		}
+	}
+
+	c := OrientationCase(value)
+	if err := c.check(); err != nil {
+		return "", err
+	}
+
+	return c, nil
+}
+
+// Valid reports whether the value is one of the constants
+func (c OrientationCase) Valid() bool {
+	switch c {
+	case OrientationCaseLandscape, OrientationCasePortrait:
+		return true
+	}
+
+	return false
+}
+
+func (c OrientationCase) String() string {
+	return string(c)
+}
+
+func (c OrientationCase) check() error {
+	if c.Valid() {
+		return nil
+	}
+
+	return &ValueError{Attr: "media", Value: string(c), Reason: "expected one of landscape, portrait"}
+}
+
+// ScanCase is the scan media feature
+type ScanCase string
+
+const (
+	ScanCaseProgressive ScanCase = "progressive"
+	ScanCaseInterlace   ScanCase = "interlace"
+)
+
+// ScanCaseValues returns the constants of ScanCase, the deprecated ones are left out
+func ScanCaseValues() []ScanCase {
+	return []ScanCase{ScanCaseProgressive, ScanCaseInterlace}
+}
+
+// ParseScanCase returns the constant that matches the value ignoring case
+func ParseScanCase(value string) (ScanCase, error) {
+	for _, c := range ScanCaseValues() {
+		if strings.EqualFold(string(c), value) {
+			return c, nil
+		}
+	}
+
+	c := ScanCase(value)
+	if err := c.check(); err != nil {
+		return "", err
+	}
+
+	return c, nil
+}
+
+// Valid reports whether the value is one of the constants
+func (c ScanCase) Valid() bool {
+	switch c {
+	case ScanCaseProgressive, ScanCaseInterlace:
+		return true
+	}
+
+	return false
+}
+
+func (c ScanCase) String() string {
+	return string(c)
+}
+
+func (c ScanCase) check() error {
+	if c.Valid() {
+		return nil
+	}
+
+	return &ValueError{Attr: "media", Value: string(c), Reason: "expected one of progressive, interlace"}
+}
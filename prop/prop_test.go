@@ -0,0 +1,150 @@
+package prop
+
+import (
+	"strings"
+	"testing"
+
+	_ "github.com/Hand-of-Doom/Vecty-Props/prop/native"
+	"github.com/hexops/vecty"
+)
+
+// renderAttrs renders the element with the applyers and returns its HTML
+func renderAttrs(t *testing.T, element string, applyers ...vecty.Applyer) string {
+	t.Helper()
+
+	var html strings.Builder
+	if err := RenderHTML(&html, vecty.Tag(element, vecty.Markup(applyers...))); err != nil {
+		t.Fatal(err)
+	}
+
+	return html.String()
+}
+
+func TestSrcset(t *testing.T) {
+	tests := []struct {
+		name  string
+		pairs []*SrcsetPair
+		want  string
+		err   bool
+	}{
+		{name: "no pairs", want: `<img srcset="">`, err: true},
+		{name: "one pair", pairs: []*SrcsetPair{NewSrcsetPair("/a.png").Width(200)}, want: `<img srcset="/a.png 200w">`},
+		{name: "two pairs", pairs: []*SrcsetPair{NewSrcsetPair("/a.png").PixelDensity(1), NewSrcsetPair("/b.png").PixelDensity(2)},
+			want: `<img srcset="/a.png 1x, /b.png 2x">`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := renderAttrs(t, "img", Srcset(tt.pairs...)); got != tt.want {
+				t.Errorf("Srcset = %s, want %s", got, tt.want)
+			}
+
+			_, err := SrcsetE(tt.pairs...)
+			if (err != nil) != tt.err {
+				t.Errorf("SrcsetE error = %v, want error %v", err, tt.err)
+			}
+		})
+	}
+
+	if _, err := SrcsetE(NewSrcsetPair("/a.png")); err == nil {
+		t.Error("SrcsetE takes a pair without a descriptor")
+	}
+}
+
+func TestCheckedHelperE(t *testing.T) {
+	tests := []struct {
+		name  string
+		build func() (vecty.Applyer, error)
+		want  string
+		err   bool
+	}{
+		{name: "TargetE", build: func() (vecty.Applyer, error) { return TargetE(TargetCaseBlank) }, want: `<a target="_blank"></a>`},
+		{name: "TargetE of a name", build: func() (vecty.Applyer, error) { return TargetE("preview") }, want: `<a target="preview"></a>`},
+		{name: "TargetE of a bad keyword", build: func() (vecty.Applyer, error) { return TargetE("_new") }, err: true},
+		{name: "InputTypeE", build: func() (vecty.Applyer, error) { return InputTypeE("colour") }, err: true},
+		{name: "MIMETypeE", build: func() (vecty.Applyer, error) { return MIMETypeE("text") }, err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			applyer, err := tt.build()
+			if (err != nil) != tt.err {
+				t.Fatalf("error = %v, want error %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+
+			if got := renderAttrs(t, "a", applyer); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Target doesn't panic on a bad keyword")
+		}
+	}()
+	Target("_new")
+}
+
+func TestRel(t *testing.T) {
+	tests := []struct {
+		name    string
+		element string
+		tokens  []RelToken
+		want    string
+		err     bool
+	}{
+		{name: "repeated tokens", element: "a", tokens: []RelToken{RelTokenNoOpener, RelTokenNoReferrer, RelTokenNoOpener},
+			want: `<a rel="noopener noreferrer"></a>`},
+		{name: "form", element: "form", tokens: []RelToken{RelTokenNofollow}, want: `<form rel="nofollow"></form>`},
+		{name: "link type of <link> on <form>", element: "form", tokens: []RelToken{RelTokenPreload}, err: true},
+		{name: "unknown token", element: "a", tokens: []RelToken{"bogus"}, err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			applyer, err := RelE(tt.element, tt.tokens...)
+			if (err != nil) != tt.err {
+				t.Fatalf("RelE error = %v, want error %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+
+			if got := renderAttrs(t, tt.element, applyer); got != tt.want {
+				t.Errorf("RelE = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	// Rel checks the tokens when it is called, rendering never panics
+	if got, want := renderAttrs(t, "form", Rel(RelTokenPreload)), `<form rel="preload"></form>`; got != want {
+		t.Errorf("Rel = %s, want %s", got, want)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Rel doesn't panic on an unknown token")
+		}
+	}()
+	Rel("bogus")
+}
+
+func TestDeprecatedConstants(t *testing.T) {
+	if TypeCaseMin.Valid() {
+		t.Error("TypeCaseMin is valid")
+	}
+	if _, err := TypeE(TypeCaseMin); err != nil {
+		t.Errorf("TypeE(TypeCaseMin) error = %v, the deprecated constants must keep working", err)
+	}
+	if _, err := TypeE("colour"); err == nil {
+		t.Error(`TypeE takes "colour"`)
+	}
+
+	if got, want := renderAttrs(t, "input", Type(TypeCaseToolbar)), `<input type="toolbar">`; got != want {
+		t.Errorf("Type = %s, want %s", got, want)
+	}
+}
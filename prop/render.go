@@ -0,0 +1,33 @@
+package prop
+
+import (
+	"io"
+
+	"github.com/hexops/vecty"
+)
+
+// RenderHTML writes the HTML of the vecty tree, components are rendered and event listeners are dropped.
+// It works outside of a browser, so pages can be prerendered on a server,
+// the program has to import prop/native there since vecty refuses to load otherwise
+func RenderHTML(w io.Writer, c vecty.ComponentOrHTML) error {
+	tree, err := FromVecty(c)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, tree.buildTree())
+
+	return err
+}
+
+// RenderDocument is RenderHTML that starts with <!DOCTYPE html>
+func RenderDocument(w io.Writer, c vecty.ComponentOrHTML) error {
+	tree, err := FromVecty(c)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, tree.(*Document).Doctype(true).buildTree())
+
+	return err
+}
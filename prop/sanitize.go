@@ -0,0 +1,313 @@
+package prop
+
+import (
+	"strings"
+)
+
+var (
+	// urlAttrs are the attributes whose values are loaded or navigated to
+	urlAttrs = map[string]bool{
+		"action": true, "background": true, "cite": true, "data": true, "formaction": true,
+		"href": true, "icon": true, "longdesc": true, "manifest": true, "poster": true,
+		"src": true, "srcset": true, "xlink:href": true,
+	}
+
+	urlSpaces = strings.NewReplacer("\t", "", "\n", "", "\r", "")
+)
+
+// Removal is a part of a tree dropped by a Policy
+type Removal struct {
+	Element string
+	// Attr is empty when the whole element or comment was removed
+	Attr   string
+	Value  string
+	Reason string
+}
+
+// Policy is an allowlist of elements, attributes and URL schemes for FakeDOM trees.
+// Elements that are not allowed are replaced by their content, denied elements are dropped with it.
+// Event handler attributes (on*) are always removed
+type Policy struct {
+	elements    map[string]bool
+	denied      map[string]bool
+	globalAttrs map[string]bool
+	attrs       map[string]map[string]bool
+	deniedAttrs map[string]bool
+	schemes     map[string]bool
+}
+
+func (b *Policy) AllowElements(names ...string) *Policy {
+	for _, name := range names {
+		name = strings.ToLower(name)
+
+		b.elements[name] = true
+		delete(b.denied, name)
+	}
+
+	return b
+}
+
+// DenyElements drops the elements together with their content
+func (b *Policy) DenyElements(names ...string) *Policy {
+	for _, name := range names {
+		name = strings.ToLower(name)
+
+		b.denied[name] = true
+		delete(b.elements, name)
+	}
+
+	return b
+}
+
+// AllowGlobalAttrs allows the attributes on every allowed element
+func (b *Policy) AllowGlobalAttrs(names ...string) *Policy {
+	for _, name := range names {
+		name = strings.ToLower(name)
+
+		b.globalAttrs[name] = true
+		delete(b.deniedAttrs, name)
+	}
+
+	return b
+}
+
+// AllowAttrsOn allows the attributes on the element only
+func (b *Policy) AllowAttrsOn(element string, names ...string) *Policy {
+	element = strings.ToLower(element)
+	if b.attrs[element] == nil {
+		b.attrs[element] = map[string]bool{}
+	}
+
+	for _, name := range names {
+		name = strings.ToLower(name)
+
+		b.attrs[element][name] = true
+		delete(b.deniedAttrs, name)
+	}
+
+	return b
+}
+
+// DenyAttrs removes the attributes from every element even if they were allowed
+func (b *Policy) DenyAttrs(names ...string) *Policy {
+	for _, name := range names {
+		b.deniedAttrs[strings.ToLower(name)] = true
+	}
+
+	return b
+}
+
+// AllowURLSchemes specifies the schemes allowed in href, src and the other URL attributes.
+// Relative URLs are always allowed
+func (b *Policy) AllowURLSchemes(schemes ...string) *Policy {
+	for _, scheme := range schemes {
+		b.schemes[strings.ToLower(strings.TrimSuffix(scheme, ":"))] = true
+	}
+
+	return b
+}
+
+// Sanitize returns a copy of the tree without everything the policy doesn't allow, RawNode content is parsed first.
+// The result is a *Document
+func (b *Policy) Sanitize(tree FakeDOM) (FakeDOM, []Removal, error) {
+	s := &sanitizer{
+		policy: b,
+	}
+
+	nodes, err := s.nodes([]FakeDOM{tree})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	doc := &Document{
+		nodes: nodes,
+	}
+	if tree, ok := tree.(*Document); ok {
+		doc.doctype = tree.doctype
+	}
+
+	return doc, s.removed, nil
+}
+
+func (b *Policy) allowedAttr(element, name string) bool {
+	if b.deniedAttrs[name] {
+		return false
+	}
+
+	return b.globalAttrs[name] || b.attrs[element][name]
+}
+
+func (b *Policy) allowedURL(value string) bool {
+	scheme := urlScheme(value)
+
+	return scheme == "" || b.schemes[scheme]
+}
+
+// urlScheme returns the scheme of the URL in lower case the way a browser reads it, it is empty for relative URLs
+func urlScheme(value string) string {
+	value = urlSpaces.Replace(strings.TrimFunc(value, func(r rune) bool {
+		return r <= ' '
+	}))
+
+	colon := strings.IndexByte(value, ':')
+	if colon < 0 || strings.ContainsAny(value[:colon], "/?#") {
+		return ""
+	}
+
+	return strings.ToLower(value[:colon])
+}
+
+// NewPolicy makes a policy that allows nothing but text, <script>, <style> and other active content is denied
+func NewPolicy() *Policy {
+	policy := &Policy{
+		elements:    map[string]bool{},
+		denied:      map[string]bool{},
+		globalAttrs: map[string]bool{},
+		attrs:       map[string]map[string]bool{},
+		deniedAttrs: map[string]bool{},
+		schemes:     map[string]bool{},
+	}
+
+	return policy.DenyElements("script", "style", "iframe", "frame", "frameset", "object", "embed",
+		"applet", "template", "noscript", "noembed", "noframes", "xmp", "plaintext", "math", "svg")
+}
+
+// UGCPolicy allows basic text formatting and links to http, https and mailto URLs
+func UGCPolicy() *Policy {
+	return NewPolicy().
+		AllowElements("a", "abbr", "b", "blockquote", "br", "cite", "code", "del", "em", "i", "ins",
+			"kbd", "li", "mark", "ol", "p", "pre", "q", "s", "small", "strong", "sub", "sup", "u", "ul").
+		AllowGlobalAttrs("title", "lang", "dir").
+		AllowAttrsOn("a", "href", "hreflang").
+		AllowAttrsOn("blockquote", "cite").
+		AllowAttrsOn("q", "cite").
+		AllowAttrsOn("del", "cite", "datetime").
+		AllowAttrsOn("ins", "cite", "datetime").
+		AllowAttrsOn("ol", "start", "reversed").
+		AllowURLSchemes("http", "https", "mailto")
+}
+
+// RichTextPolicy is UGCPolicy with headings, images, figures and tables
+func RichTextPolicy() *Policy {
+	return UGCPolicy().
+		AllowElements("caption", "col", "colgroup", "dd", "div", "dl", "dt", "figcaption", "figure",
+			"h1", "h2", "h3", "h4", "h5", "h6", "hr", "img", "picture", "source", "span",
+			"table", "tbody", "td", "tfoot", "th", "thead", "tr").
+		AllowAttrsOn("img", "src", "srcset", "sizes", "alt", "width", "height", "loading").
+		AllowAttrsOn("source", "srcset", "sizes", "media", "type").
+		AllowAttrsOn("td", "colspan", "rowspan", "headers").
+		AllowAttrsOn("th", "colspan", "rowspan", "headers", "scope", "abbr").
+		AllowAttrsOn("col", "span").
+		AllowAttrsOn("colgroup", "span").
+		AllowURLSchemes("tel")
+}
+
+type sanitizer struct {
+	policy  *Policy
+	removed []Removal
+}
+
+func (s *sanitizer) nodes(nodes []FakeDOM) ([]FakeDOM, error) {
+	var clean []FakeDOM
+
+	for _, node := range nodes {
+		switch node := node.(type) {
+		case *TextNode:
+			clean = append(clean, NewTextNode(node.text))
+		case *CommentNode:
+			s.removed = append(s.removed, Removal{Value: node.text, Reason: "comments are not allowed"})
+		case *Document:
+			children, err := s.nodes(node.nodes)
+			if err != nil {
+				return nil, err
+			}
+
+			clean = append(clean, children...)
+		case *Node:
+			children, err := s.node(node)
+			if err != nil {
+				return nil, err
+			}
+
+			clean = append(clean, children...)
+		default:
+			tree, err := ParseFakeDOM(strings.NewReader(node.buildTree()))
+			if err != nil {
+				return nil, err
+			}
+
+			children, err := s.nodes([]FakeDOM{tree})
+			if err != nil {
+				return nil, err
+			}
+
+			clean = append(clean, children...)
+		}
+	}
+
+	return clean, nil
+}
+
+func (s *sanitizer) node(node *Node) ([]FakeDOM, error) {
+	name := strings.ToLower(node.name)
+
+	if s.policy.denied[name] {
+		s.removed = append(s.removed, Removal{Element: name, Reason: "element is denied"})
+		return nil, nil
+	}
+
+	children, err := s.nodes(node.nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.policy.elements[name] {
+		s.removed = append(s.removed, Removal{Element: name, Reason: "element is not allowed"})
+		return children, nil
+	}
+
+	clean := &Node{
+		name:  name,
+		nodes: children,
+	}
+
+	for _, attr := range node.attrs {
+		key := strings.ToLower(attr.key)
+
+		switch {
+		case strings.HasPrefix(key, "on"):
+			s.removeAttr(name, attr, "event handlers are not allowed")
+		case !s.policy.allowedAttr(name, key):
+			s.removeAttr(name, attr, "attribute is not allowed")
+		case urlAttrs[key] && !s.allowedURLs(key, attr.value):
+			s.removeAttr(name, attr, "URL scheme is not allowed")
+		default:
+			clean.attrs = append(clean.attrs, &Attr{key: key, value: attr.value, boolean: attr.boolean})
+		}
+	}
+
+	return []FakeDOM{clean}, nil
+}
+
+func (s *sanitizer) allowedURLs(key, value string) bool {
+	if key != "srcset" {
+		return s.policy.allowedURL(value)
+	}
+
+	for _, candidate := range strings.Split(value, ",") {
+		if fields := strings.Fields(candidate); len(fields) != 0 && !s.policy.allowedURL(fields[0]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *sanitizer) removeAttr(element string, attr *Attr, reason string) {
+	s.removed = append(s.removed, Removal{
+		Element: element,
+		Attr:    attr.key,
+		Value:   attr.value,
+		Reason:  reason,
+	})
+}
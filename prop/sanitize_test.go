@@ -0,0 +1,64 @@
+package prop
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSanitize(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  *Policy
+		html    string
+		want    string
+		removed int
+	}{
+		{name: "text", policy: NewPolicy(), html: "<p>a &amp; b</p>", want: "a &amp; b", removed: 1},
+		{name: "denied content", policy: UGCPolicy(), html: "<p>a<script>alert(1)</script></p>", want: "<p>a</p>", removed: 1},
+		{name: "unwrapped element", policy: UGCPolicy(), html: "<div><b>a</b></div>", want: "<b>a</b>", removed: 1},
+		{name: "event handler", policy: UGCPolicy(), html: `<b onclick="alert(1)">a</b>`, want: "<b>a</b>", removed: 1},
+		{name: "attribute", policy: UGCPolicy(), html: `<b title="t" style="color: red">a</b>`, want: `<b title="t">a</b>`, removed: 1},
+		{name: "attribute of another element", policy: UGCPolicy(), html: `<b href="/a">a</b>`, want: "<b>a</b>", removed: 1},
+		{name: "link", policy: UGCPolicy(), html: `<a href="https://example.com">a</a>`, want: `<a href="https://example.com">a</a>`},
+		{name: "relative link", policy: UGCPolicy(), html: `<a href="/a">a</a>`, want: `<a href="/a">a</a>`},
+		{name: "script URL", policy: UGCPolicy(), html: `<a href="javascript:alert(1)">a</a>`, want: "<a>a</a>", removed: 1},
+		{name: "obfuscated script URL", policy: UGCPolicy(), html: "<a href=\" java\tscript:alert(1)\">a</a>", want: "<a>a</a>", removed: 1},
+		{name: "srcset", policy: RichTextPolicy(), html: `<img srcset="/a.png 1x, javascript:alert(1) 2x">`, want: "<img>", removed: 1},
+		{name: "tel", policy: RichTextPolicy(), html: `<a href="tel:+123">a</a>`, want: `<a href="tel:+123">a</a>`},
+		{name: "comment", policy: UGCPolicy(), html: "<b><!-- a --></b>", want: "<b></b>", removed: 1},
+		{name: "denied attribute", policy: UGCPolicy().DenyAttrs("title"), html: `<b title="t">a</b>`, want: "<b>a</b>", removed: 1},
+		{name: "upper case", policy: UGCPolicy(), html: `<B TITLE="t">a</B>`, want: `<b title="t">a</b>`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree, err := ParseFakeDOM(strings.NewReader(tt.html))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			clean, removed, err := tt.policy.Sanitize(tree)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := clean.buildTree(); got != tt.want {
+				t.Errorf("Sanitize = %s, want %s", got, tt.want)
+			}
+			if len(removed) != tt.removed {
+				t.Errorf("Sanitize removed %v, want %d removals", removed, tt.removed)
+			}
+		})
+	}
+}
+
+func TestSanitizeRawNode(t *testing.T) {
+	clean, _, err := UGCPolicy().Sanitize(NewRawNode(`<b>a</b><img src=x onerror=alert(1)>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := clean.buildTree(), "<b>a</b>"; got != want {
+		t.Errorf("Sanitize = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,652 @@
+package prop
+
+import (
+	"fmt"
+	"math/big"
+	"mime/multipart"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf16"
+
+	"github.com/hexops/vecty"
+)
+
+// stepTypes are the input types with min, max and step, parse reads a submitted value into the units of the step
+var stepTypes = map[InputTypeCase]struct {
+	parse func(value string) (*big.Rat, bool)
+	step  int64
+	unit  string
+}{
+	InputTypeCaseNumber:        {parse: parseDecimal, step: 1, unit: "units"},
+	InputTypeCaseRange:         {parse: parseDecimal, step: 1, unit: "units"},
+	InputTypeCaseDate:          {parse: parseSteppable(parseDate), step: 1, unit: "days"},
+	InputTypeCaseMonth:         {parse: parseSteppable(parseMonth), step: 1, unit: "months"},
+	InputTypeCaseWeek:          {parse: parseSteppable(parseWeek), step: 1, unit: "weeks"},
+	InputTypeCaseTime:          {parse: parseSteppable(parseTime), step: 60, unit: "seconds"},
+	InputTypeCaseDatetimeLocal: {parse: parseSteppable(parseLocalDatetime), step: 60, unit: "seconds"},
+}
+
+// textTypes are the input types with pattern and maxlength, the empty type is a <textarea> or an <input> without a type
+var textTypes = map[InputTypeCase]bool{
+	"":                    true,
+	InputTypeCaseText:     true,
+	InputTypeCaseSearch:   true,
+	InputTypeCaseURL:      true,
+	InputTypeCaseTel:      true,
+	InputTypeCaseEmail:    true,
+	InputTypeCasePassword: true,
+}
+
+var (
+	floatValue    = regexp.MustCompile(`^-?(?:[0-9]+(?:\.[0-9]+)?|\.[0-9]+)(?:[eE][+-]?[0-9]+)?$`)
+	dateValue     = regexp.MustCompile(`^([0-9]{4,})-([0-9]{2})-([0-9]{2})$`)
+	monthValue    = regexp.MustCompile(`^([0-9]{4,})-([0-9]{2})$`)
+	weekValue     = regexp.MustCompile(`^([0-9]{4,})-W([0-9]{2})$`)
+	timeValue     = regexp.MustCompile(`^([0-9]{2}):([0-9]{2})(?::([0-9]{2})(?:\.([0-9]{1,3}))?)?$`)
+	datetimeValue = regexp.MustCompile(`^([^T ]+)[T ](.+)$`)
+	// emailValue is the valid email address of the HTML standard
+	emailValue = regexp.MustCompile("^[a-zA-Z0-9.!#$%&'*+/=?^_`{|}~-]+@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?" +
+		`(?:\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$`)
+)
+
+// parseDecimal reads a valid floating-point number, the value is rounded to a float64 as browsers do
+func parseDecimal(value string) (*big.Rat, bool) {
+	if !floatValue.MatchString(value) {
+		return nil, false
+	}
+
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, false
+	}
+
+	// the shortest form keeps 0.1 exact and the exponent small
+	return new(big.Rat).SetString(strconv.FormatFloat(f, 'g', -1, 64))
+}
+
+func parseSteppable(parse func(value string) (steppable, bool)) func(value string) (*big.Rat, bool) {
+	return func(value string) (*big.Rat, bool) {
+		v, ok := parse(value)
+		if !ok || v.Validate() != nil {
+			return nil, false
+		}
+
+		return v.number(), true
+	}
+}
+
+// atoi reads the digits matched by the value patterns, an empty match is zero
+func atoi(digits string) (int, bool) {
+	if digits == "" {
+		return 0, true
+	}
+
+	n, err := strconv.Atoi(digits)
+
+	return n, err == nil
+}
+
+func parseDate(value string) (steppable, bool) {
+	match := dateValue.FindStringSubmatch(value)
+	if match == nil {
+		return nil, false
+	}
+
+	year, ok := atoi(match[1])
+	if !ok {
+		return nil, false
+	}
+	month, _ := atoi(match[2])
+	day, _ := atoi(match[3])
+
+	return NewDate(year, time.Month(month), day), true
+}
+
+func parseMonth(value string) (steppable, bool) {
+	match := monthValue.FindStringSubmatch(value)
+	if match == nil {
+		return nil, false
+	}
+
+	year, ok := atoi(match[1])
+	if !ok {
+		return nil, false
+	}
+	month, _ := atoi(match[2])
+
+	return NewMonth(year, time.Month(month)), true
+}
+
+func parseWeek(value string) (steppable, bool) {
+	match := weekValue.FindStringSubmatch(value)
+	if match == nil {
+		return nil, false
+	}
+
+	year, ok := atoi(match[1])
+	if !ok {
+		return nil, false
+	}
+	week, _ := atoi(match[2])
+
+	return NewWeek(year, week), true
+}
+
+func parseTimeOfDay(value string) (*TimeOfDay, bool) {
+	match := timeValue.FindStringSubmatch(value)
+	if match == nil {
+		return nil, false
+	}
+
+	hour, _ := atoi(match[1])
+	minute, _ := atoi(match[2])
+	second, _ := atoi(match[3])
+	// .5 is 500 milliseconds
+	millisecond, _ := atoi((match[4] + "000")[:3])
+
+	return NewTimeOfDay(hour, minute, second).Millisecond(millisecond), true
+}
+
+func parseTime(value string) (steppable, bool) {
+	clock, ok := parseTimeOfDay(value)
+	if !ok {
+		return nil, false
+	}
+
+	return clock, true
+}
+
+func parseLocalDatetime(value string) (steppable, bool) {
+	match := datetimeValue.FindStringSubmatch(value)
+	if match == nil {
+		return nil, false
+	}
+
+	date, ok := parseDate(match[1])
+	if !ok {
+		return nil, false
+	}
+	clock, ok := parseTimeOfDay(match[2])
+	if !ok {
+		return nil, false
+	}
+
+	return NewLocalDatetime(date.(*Date), clock), true
+}
+
+// ValidityState is the result of the constraint validation of a field, the flags are named as in the DOM
+type ValidityState struct {
+	ValueMissing bool
+	// TypeMismatch is also set for the values of number, range, date and time inputs that can't be parsed,
+	// browsers report them as badInput
+	TypeMismatch    bool
+	PatternMismatch bool
+	TooLong         bool
+	RangeUnderflow  bool
+	RangeOverflow   bool
+	StepMismatch    bool
+}
+
+// Valid reports whether every constraint is satisfied
+func (v ValidityState) Valid() bool {
+	return v == ValidityState{}
+}
+
+// String lists the flags that are set
+// ex: valueMissing, tooLong
+func (v ValidityState) String() string {
+	var flags []string
+	for _, flag := range []struct {
+		name string
+		set  bool
+	}{
+		{"valueMissing", v.ValueMissing},
+		{"typeMismatch", v.TypeMismatch},
+		{"patternMismatch", v.PatternMismatch},
+		{"tooLong", v.TooLong},
+		{"rangeUnderflow", v.RangeUnderflow},
+		{"rangeOverflow", v.RangeOverflow},
+		{"stepMismatch", v.StepMismatch},
+	} {
+		if flag.set {
+			flags = append(flags, flag.name)
+		}
+	}
+
+	if len(flags) == 0 {
+		return "valid"
+	}
+
+	return strings.Join(flags, ", ")
+}
+
+// FieldError reports the field of the submission that doesn't satisfy its constraints
+type FieldError struct {
+	Name     string
+	Validity ValidityState
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("field %s: %s", e.Name, e.Validity)
+}
+
+// FormErrors are every invalid field of the submission in the order of the schema
+type FormErrors []*FieldError
+
+func (e FormErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// Field is a form control with the constraints checked by the browser and by FormSchema on the server
+// ex: NewField("age").Type(InputTypeCaseNumber).Required(true).Min(Number(18))
+type Field struct {
+	name      string
+	typ       InputTypeCase
+	required  bool
+	multiple  bool
+	pattern   *regexp.Regexp
+	anchored  *regexp.Regexp
+	maxLength *uint64
+	min, max  FormValue
+	step      *float64
+
+	id          *EntityRef
+	placeholder string
+	label       string
+}
+
+// Type sets the input type, it is empty for <textarea> and <select>
+func (f *Field) Type(c InputTypeCase) *Field {
+	f.typ = c
+
+	return f
+}
+
+func (f *Field) Required(flag bool) *Field {
+	f.required = flag
+
+	return f
+}
+
+// Multiple allows a comma-separated list of addresses in an email input
+func (f *Field) Multiple(flag bool) *Field {
+	f.multiple = flag
+
+	return f
+}
+
+// Pattern must match the whole value
+func (f *Field) Pattern(value *regexp.Regexp) *Field {
+	f.pattern = value
+	f.anchored = regexp.MustCompile(`^(?:` + value.String() + `)$`)
+
+	return f
+}
+
+// MaxLength is measured in UTF-16 code units as in the browser
+func (f *Field) MaxLength(value uint64) *Field {
+	f.maxLength = &value
+
+	return f
+}
+
+// Min takes the same values as the Min helper
+func (f *Field) Min(value FormValue) *Field {
+	f.min = value
+
+	return f
+}
+
+// Max takes the same values as the Max helper
+func (f *Field) Max(value FormValue) *Field {
+	f.max = value
+
+	return f
+}
+
+// Step is in the units of the type: days, weeks, months or seconds, zero means any
+func (f *Field) Step(value float64) *Field {
+	f.step = &value
+
+	return f
+}
+
+// ID identifies the control, For of its <label> refers to it
+func (f *Field) ID(ref EntityRef) *Field {
+	f.id = &ref
+
+	return f
+}
+
+func (f *Field) Placeholder(value string) *Field {
+	f.placeholder = value
+
+	return f
+}
+
+// Label sets the text of the <label> of the control
+func (f *Field) Label(text string) *Field {
+	f.label = text
+
+	return f
+}
+
+func (f *Field) Name() string {
+	return f.name
+}
+
+func (f *Field) LabelText() string {
+	return f.label
+}
+
+// For is the For applyer of the <label> of the control, panics if the field has no ID
+// ex: elem.Label(vecty.Markup(field.For()), vecty.Text(field.LabelText()))
+func (f *Field) For() vecty.Applyer {
+	if f.id == nil {
+		panic("prop: field " + f.name + " has no id to refer to")
+	}
+
+	return For(*f.id)
+}
+
+// constraints are nil for the types without min, max and step
+func (f *Field) constraints() (*constraints, error) {
+	t, ok := stepTypes[f.typ]
+	if !ok {
+		if f.min != nil || f.max != nil || f.step != nil {
+			return nil, &ValueError{Attr: "type", Value: string(f.typ), Reason: "min, max and step apply only to number, range, date and time inputs"}
+		}
+
+		return nil, nil
+	}
+
+	c := &constraints{typ: f.typ, defaultStep: big.NewRat(t.step, 1), unit: t.unit}
+
+	min, max := f.min, f.max
+	if f.typ == InputTypeCaseRange {
+		// the defaults of the range input
+		if min == nil {
+			min = Number(0)
+		}
+		if max == nil {
+			max = Number(100)
+		}
+	}
+
+	for _, bound := range []struct {
+		attr  string
+		value FormValue
+	}{{"min", min}, {"max", max}} {
+		if bound.value == nil {
+			continue
+		}
+
+		tpl, err := formatValue(bound.attr, bound.value)
+		if err != nil {
+			return nil, err
+		}
+
+		number, ok := t.parse(tpl)
+		if !ok {
+			return nil, &ValueError{Attr: bound.attr, Value: tpl, Reason: "not a valid " + f.typ.String() + " value"}
+		}
+		c.set(bound.attr, tpl, number, nil)
+	}
+
+	if f.step != nil {
+		c.setStep(*f.step)
+	}
+
+	return c, c.validate()
+}
+
+// Validate reports the constraints that don't apply to the type and min, max or step that are not valid
+func (f *Field) Validate() error {
+	if f.name == "" {
+		return &ValueError{Attr: "name", Reason: "a field needs a name to be submitted"}
+	}
+
+	if f.typ != "" && !f.typ.Valid() {
+		return f.typ.check()
+	}
+
+	if !textTypes[f.typ] {
+		if f.pattern != nil {
+			return &ValueError{Attr: "pattern", Value: f.pattern.String(), Reason: "pattern does not apply to " + f.typ.String() + " inputs"}
+		}
+		if f.maxLength != nil {
+			return &ValueError{Attr: "maxlength", Value: fmt.Sprint(*f.maxLength), Reason: "maxlength does not apply to " + f.typ.String() + " inputs"}
+		}
+	}
+	if f.pattern != nil {
+		if _, err := TranslatePattern(f.pattern); err != nil {
+			return err
+		}
+	}
+	if f.multiple && f.typ != InputTypeCaseEmail && f.typ != InputTypeCaseFile {
+		return &ValueError{Attr: "multiple", Reason: "multiple applies only to email and file inputs"}
+	}
+
+	_, err := f.constraints()
+
+	return err
+}
+
+// Build returns Name, ID, Type, Required, Multiple, Pattern, MaxLength, Min, Max, Step and Placeholder of the control
+func (f *Field) Build() (vecty.Applyer, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
+	// the name of a form control is any string, NameCase checks the names of <meta>
+	markup := []vecty.Applyer{applyAttr("name", f.name)}
+	if f.id != nil {
+		markup = append(markup, ID(*f.id))
+	}
+
+	c, _ := f.constraints()
+	if c != nil {
+		constraints, err := c.build()
+		if err != nil {
+			return nil, err
+		}
+		markup = append(markup, constraints)
+	} else if f.typ != "" {
+		markup = append(markup, InputType(f.typ))
+	}
+
+	if f.required {
+		markup = append(markup, Required(true))
+	}
+	if f.multiple {
+		markup = append(markup, Multiple(true))
+	}
+	if f.pattern != nil {
+		markup = append(markup, Pattern(f.pattern))
+	}
+	if f.maxLength != nil {
+		markup = append(markup, MaxLength(*f.maxLength))
+	}
+	if f.placeholder != "" {
+		markup = append(markup, Placeholder(f.placeholder))
+	}
+
+	return vecty.Markup(markup...), nil
+}
+
+// Validity runs the constraint validation on the submitted values of the field, every value is checked.
+// Panics if the field is not valid
+func (f *Field) Validity(values ...string) ValidityState {
+	if err := f.Validate(); err != nil {
+		panic(err)
+	}
+	c, _ := f.constraints()
+
+	var v ValidityState
+	present := false
+
+	for _, value := range values {
+		if f.typ == InputTypeCaseEmail || f.typ == InputTypeCaseURL {
+			value = strings.TrimSpace(value)
+		}
+		if value == "" {
+			continue
+		}
+		present = true
+
+		if c != nil {
+			f.checkNumber(c, value, &v)
+			continue
+		}
+
+		items := []string{value}
+		if f.typ == InputTypeCaseEmail && f.multiple {
+			items = strings.Split(value, ",")
+		}
+		for _, item := range items {
+			f.checkText(strings.TrimSpace(item), &v)
+		}
+
+		if f.maxLength != nil && uint64(len(utf16.Encode([]rune(value)))) > *f.maxLength {
+			v.TooLong = true
+		}
+	}
+
+	v.ValueMissing = f.required && !present
+
+	return v
+}
+
+func (f *Field) checkNumber(c *constraints, value string, v *ValidityState) {
+	number, ok := stepTypes[f.typ].parse(value)
+	if !ok {
+		v.TypeMismatch = true
+		return
+	}
+
+	if c.min != nil && number.Cmp(c.min) < 0 {
+		v.RangeUnderflow = true
+	}
+	if c.max != nil && number.Cmp(c.max) > 0 {
+		v.RangeOverflow = true
+	}
+	if c.offStep(number) {
+		v.StepMismatch = true
+	}
+}
+
+func (f *Field) checkText(value string, v *ValidityState) {
+	switch f.typ {
+	case InputTypeCaseEmail:
+		if !emailValue.MatchString(value) {
+			v.TypeMismatch = true
+		}
+	case InputTypeCaseURL:
+		if u, err := url.Parse(value); err != nil || !u.IsAbs() {
+			v.TypeMismatch = true
+		}
+	}
+
+	if f.anchored != nil && !f.anchored.MatchString(value) {
+		v.PatternMismatch = true
+	}
+}
+
+func NewField(name string) *Field {
+	return &Field{
+		name: name,
+	}
+}
+
+// FormSchema is the single source of the constraints of a form: Build gives the props of each control
+// and ValidateValues runs the same constraint validation on the submission
+type FormSchema struct {
+	fields []*Field
+}
+
+// Field returns nil when there is no field with the name
+func (s *FormSchema) Field(name string) *Field {
+	for _, f := range s.fields {
+		if f.name == name {
+			return f
+		}
+	}
+
+	return nil
+}
+
+func (s *FormSchema) Fields() []*Field {
+	return s.fields
+}
+
+// Validate reports the first field that is not valid and fields with the same name
+func (s *FormSchema) Validate() error {
+	names := map[string]bool{}
+	for _, f := range s.fields {
+		if err := f.Validate(); err != nil {
+			return err
+		}
+		if names[f.name] {
+			return &ValueError{Attr: "name", Value: f.name, Reason: "the schema has two fields with the name"}
+		}
+		names[f.name] = true
+	}
+
+	return nil
+}
+
+// ValidateValues runs the constraint validation on the submission, the error is FormErrors
+// unless the schema itself is not valid
+func (s *FormSchema) ValidateValues(values url.Values) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+
+	var errs FormErrors
+	for _, f := range s.fields {
+		if v := f.Validity(values[f.name]...); !v.Valid() {
+			errs = append(errs, &FieldError{Name: f.name, Validity: v})
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errs
+}
+
+// ValidateMultipart is ValidateValues for multipart/form-data, file inputs are checked against the uploaded files
+func (s *FormSchema) ValidateMultipart(form *multipart.Form) error {
+	values := url.Values{}
+	for name, v := range form.Value {
+		values[name] = v
+	}
+
+	for _, f := range s.fields {
+		if f.typ != InputTypeCaseFile {
+			continue
+		}
+
+		values[f.name] = nil
+		for _, file := range form.File[f.name] {
+			values[f.name] = append(values[f.name], file.Filename)
+		}
+	}
+
+	return s.ValidateValues(values)
+}
+
+func NewFormSchema(fields ...*Field) *FormSchema {
+	return &FormSchema{
+		fields: fields,
+	}
+}
@@ -0,0 +1,121 @@
+package prop
+
+import (
+	"errors"
+	"net/url"
+	"regexp"
+	"testing"
+)
+
+func TestValidity(t *testing.T) {
+	tests := []struct {
+		name   string
+		field  *Field
+		values []string
+		want   ValidityState
+	}{
+		{name: "required", field: NewField("a").Required(true), values: []string{""}, want: ValidityState{ValueMissing: true}},
+		{name: "required present", field: NewField("a").Required(true), values: []string{"x"}},
+		{name: "optional empty", field: NewField("a").Type(InputTypeCaseNumber).Min(Number(1)), values: []string{""}},
+		{name: "number", field: NewField("a").Type(InputTypeCaseNumber), values: []string{"1e"}, want: ValidityState{TypeMismatch: true}},
+		{name: "underflow", field: NewField("a").Type(InputTypeCaseNumber).Min(Number(18)), values: []string{"17"}, want: ValidityState{RangeUnderflow: true}},
+		{name: "overflow", field: NewField("a").Type(InputTypeCaseNumber).Max(Number(10)), values: []string{"11"}, want: ValidityState{RangeOverflow: true}},
+		{name: "step", field: NewField("a").Type(InputTypeCaseNumber).Min(Number(1)).Step(2), values: []string{"4"}, want: ValidityState{StepMismatch: true}},
+		{name: "step from min", field: NewField("a").Type(InputTypeCaseNumber).Min(Number(1)).Step(2), values: []string{"5"}},
+		{name: "range", field: NewField("a").Type(InputTypeCaseRange), values: []string{"101"}, want: ValidityState{RangeOverflow: true}},
+		{name: "date", field: NewField("a").Type(InputTypeCaseDate).Min(Text("2024-01-01")), values: []string{"2023-12-31"}, want: ValidityState{RangeUnderflow: true}},
+		{name: "bad date", field: NewField("a").Type(InputTypeCaseDate), values: []string{"2024-02-30"}, want: ValidityState{TypeMismatch: true}},
+		{name: "email", field: NewField("a").Type(InputTypeCaseEmail), values: []string{" john@example.com "}},
+		{name: "bad email", field: NewField("a").Type(InputTypeCaseEmail), values: []string{"john"}, want: ValidityState{TypeMismatch: true}},
+		{name: "multiple emails", field: NewField("a").Type(InputTypeCaseEmail).Multiple(true), values: []string{"a@example.com, b"}, want: ValidityState{TypeMismatch: true}},
+		{name: "relative url", field: NewField("a").Type(InputTypeCaseURL), values: []string{"/a"}, want: ValidityState{TypeMismatch: true}},
+		{name: "pattern", field: NewField("a").Pattern(regexp.MustCompile("[a-z]+")), values: []string{"abc1"}, want: ValidityState{PatternMismatch: true}},
+		{name: "max length in UTF-16", field: NewField("a").MaxLength(2), values: []string{"😀"}},
+		{name: "too long", field: NewField("a").MaxLength(2), values: []string{"😀a"}, want: ValidityState{TooLong: true}},
+		{name: "every value", field: NewField("a").Type(InputTypeCaseNumber).Max(Number(10)), values: []string{"1", "11"}, want: ValidityState{RangeOverflow: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.field.Validity(tt.values...); got != tt.want {
+				t.Errorf("Validity = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFieldBuild(t *testing.T) {
+	tests := []struct {
+		name  string
+		field *Field
+		want  string
+	}{
+		{name: "name", field: NewField("email"), want: `<input name="email">`},
+		{name: "name of a meta keyword", field: NewField("viewport"), want: `<input name="viewport">`},
+		{name: "name with brackets", field: NewField("user[emails][]"), want: `<input name="user[emails][]">`},
+		{name: "name with spaces", field: NewField("first name"), want: `<input name="first name">`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			applyer, err := tt.field.Build()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := renderAttrs(t, "input", applyer); got != tt.want {
+				t.Errorf("Build = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFieldValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		field *Field
+	}{
+		{name: "no name", field: NewField("")},
+		{name: "pattern on a number", field: NewField("a").Type(InputTypeCaseNumber).Pattern(regexp.MustCompile("1"))},
+		{name: "maxlength on a date", field: NewField("a").Type(InputTypeCaseDate).MaxLength(2)},
+		{name: "multiple text", field: NewField("a").Multiple(true)},
+		{name: "min of another type", field: NewField("a").Type(InputTypeCaseNumber).Min(Text("2024-01-01"))},
+		{name: "unknown type", field: NewField("a").Type("colour")},
+		{name: "nil date min", field: NewField("a").Type(InputTypeCaseDate).Min((*Date)(nil))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.field.Validate(); err == nil {
+				t.Error("Validate takes the field")
+			}
+		})
+	}
+}
+
+func TestValidateValues(t *testing.T) {
+	schema := NewFormSchema(
+		NewField("name").Required(true).MaxLength(10),
+		NewField("age").Type(InputTypeCaseNumber).Min(Number(18)),
+		NewField("email").Type(InputTypeCaseEmail),
+	)
+
+	if err := schema.ValidateValues(url.Values{"name": {"John"}, "age": {"30"}}); err != nil {
+		t.Errorf("ValidateValues = %v, want nil", err)
+	}
+
+	err := schema.ValidateValues(url.Values{"age": {"17"}, "email": {"john@example.com"}})
+
+	var errs FormErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("ValidateValues error = %v, want FormErrors", err)
+	}
+	if len(errs) != 2 || errs[0].Name != "name" || !errs[0].Validity.ValueMissing ||
+		errs[1].Name != "age" || !errs[1].Validity.RangeUnderflow {
+		t.Errorf("ValidateValues = %v, want a missing name and an age below the minimum", errs)
+	}
+
+	twice := NewFormSchema(NewField("a"), NewField("a"))
+	if err := twice.ValidateValues(url.Values{}); err == nil || errors.As(err, &errs) {
+		t.Errorf("ValidateValues error = %v, want the schema error", err)
+	}
+}
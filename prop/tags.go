@@ -0,0 +1,271 @@
+package prop
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TagError reports a prop struct tag that is unknown or doesn't fit the type of the struct field
+type TagError struct {
+	Field  string
+	Option string
+	Reason string
+}
+
+func (e *TagError) Error() string {
+	return fmt.Sprintf("bad prop tag %q on field %s: %s", e.Option, e.Field, e.Reason)
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// splitTag splits the tag on commas, a comma escaped with a backslash is kept
+// ex: pattern=[0-9]{1\,3},required => pattern=[0-9]{1,3} and required
+func splitTag(tag string) []string {
+	var (
+		options []string
+		option  strings.Builder
+	)
+
+	for i := 0; i < len(tag); i++ {
+		switch {
+		case tag[i] == '\\' && i+1 < len(tag) && tag[i+1] == ',':
+			option.WriteByte(',')
+			i++
+		case tag[i] == ',':
+			options = append(options, option.String())
+			option.Reset()
+		default:
+			option.WriteByte(tag[i])
+		}
+	}
+
+	return append(options, option.String())
+}
+
+// fieldTypes are the input types a Go type can hold and the type used when the tag has none
+func fieldTypes(t reflect.Type) (InputTypeCase, map[InputTypeCase]bool, bool) {
+	switch {
+	case t == timeType:
+		return InputTypeCaseDatetimeLocal, map[InputTypeCase]bool{
+			InputTypeCaseDate:          true,
+			InputTypeCaseDatetimeLocal: true,
+			InputTypeCaseMonth:         true,
+			InputTypeCaseWeek:          true,
+			InputTypeCaseTime:          true,
+		}, true
+	case t.Kind() == reflect.Bool:
+		return InputTypeCaseCheckbox, map[InputTypeCase]bool{InputTypeCaseCheckbox: true}, true
+	case t.Kind() >= reflect.Int && t.Kind() <= reflect.Float64:
+		return InputTypeCaseNumber, map[InputTypeCase]bool{
+			InputTypeCaseNumber: true,
+			InputTypeCaseRange:  true,
+			InputTypeCaseHidden: true,
+		}, true
+	case t.Kind() == reflect.String:
+		// a string holds any submitted value except the checkbox flag
+		return InputTypeCaseText, nil, true
+	}
+
+	return "", nil, false
+}
+
+// SchemaOf reflects over the exported fields of the struct with the prop tag and returns a field for each of them,
+// the fields of embedded structs without the tag are walked as if they were fields of the struct.
+// The options are separated by commas, a comma inside a value is escaped with a backslash:
+// name, id, label, type, required, multiple, pattern, maxlength, placeholder, min, max and step.
+// The name and the label default to the name of the struct field, the id is minted from the name by the scope when it is not nil.
+// It returns an error if the value is not a struct or a pointer to a struct or an unexported field has the tag
+// ex: Email string `prop:"required,maxlength=80,type=email,placeholder=you@example.com"`
+func SchemaOf(form interface{}, scope *IDScope) (*FormSchema, error) {
+	t := reflect.TypeOf(form)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("prop: SchemaOf takes a struct, got %T", form)
+	}
+
+	fields, err := structFields(t, scope, map[reflect.Type]bool{})
+	if err != nil {
+		return nil, err
+	}
+
+	schema := NewFormSchema(fields...)
+	if err := schema.Validate(); err != nil {
+		return nil, err
+	}
+
+	return schema, nil
+}
+
+// structFields returns the fields of the struct and of the structs embedded in it, seen breaks embedding cycles
+func structFields(t reflect.Type, scope *IDScope, seen map[reflect.Type]bool) ([]*Field, error) {
+	if seen[t] {
+		return nil, nil
+	}
+	seen[t] = true
+
+	var fields []*Field
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+
+		tag, ok := sf.Tag.Lookup("prop")
+		if tag == "-" {
+			continue
+		}
+
+		if !ok {
+			if embedded := embeddedStruct(sf); embedded != nil {
+				promoted, err := structFields(embedded, scope, seen)
+				if err != nil {
+					return nil, err
+				}
+
+				fields = append(fields, promoted...)
+			}
+			continue
+		}
+
+		if sf.PkgPath != "" {
+			return nil, &TagError{Field: sf.Name, Option: tag, Reason: "the field is unexported"}
+		}
+
+		field, err := tagField(sf, tag, scope)
+		if err != nil {
+			return nil, err
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
+// embeddedStruct returns the struct type of an embedded field, the fields of an unexported embedded struct are promoted too
+func embeddedStruct(sf reflect.StructField) reflect.Type {
+	if !sf.Anonymous {
+		return nil
+	}
+
+	t := sf.Type
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || t == timeType {
+		return nil
+	}
+
+	return t
+}
+
+// MustSchemaOf is SchemaOf that panics on a bad tag, it is meant for package level variables
+func MustSchemaOf(form interface{}, scope *IDScope) *FormSchema {
+	schema, err := SchemaOf(form, scope)
+	if err != nil {
+		panic(err)
+	}
+
+	return schema
+}
+
+func tagField(sf reflect.StructField, tag string, scope *IDScope) (*Field, error) {
+	typ, allowed, ok := fieldTypes(sf.Type)
+	if !ok {
+		return nil, &TagError{Field: sf.Name, Option: tag, Reason: "fields of type " + sf.Type.String() + " can't be form controls"}
+	}
+
+	field := NewField(sf.Name).Label(sf.Name)
+	var id *EntityRef
+
+	for _, option := range splitTag(tag) {
+		key, value, hasValue := strings.Cut(option, "=")
+		bad := func(reason string) error {
+			return &TagError{Field: sf.Name, Option: option, Reason: reason}
+		}
+
+		switch key {
+		case "required", "multiple":
+			if hasValue {
+				return nil, bad(key + " takes no value")
+			}
+		case "name", "id", "label", "type", "pattern", "maxlength", "placeholder", "min", "max", "step":
+			if !hasValue || value == "" {
+				return nil, bad(key + " needs a value")
+			}
+		case "":
+			continue
+		default:
+			return nil, bad("unknown option " + key)
+		}
+
+		switch key {
+		case "required":
+			field.Required(true)
+		case "multiple":
+			field.Multiple(true)
+		case "name":
+			field.name = value
+		case "id":
+			ref := NewEntityRef(value)
+			if scope != nil {
+				ref = scope.Ref(value)
+			}
+			id = &ref
+		case "label":
+			field.Label(value)
+		case "type":
+			t, err := ParseInputTypeCase(value)
+			if err != nil {
+				return nil, bad(err.Error())
+			}
+			if allowed != nil && !allowed[t] || allowed == nil && t == InputTypeCaseCheckbox {
+				return nil, bad(sf.Type.String() + " can't hold the value of type=" + value)
+			}
+			typ = t
+		case "pattern":
+			pattern, err := regexp.Compile(value)
+			if err != nil {
+				return nil, bad(err.Error())
+			}
+			field.Pattern(pattern)
+		case "maxlength":
+			n, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return nil, bad("maxlength must be a non-negative integer")
+			}
+			field.MaxLength(n)
+		case "placeholder":
+			field.Placeholder(value)
+		case "min":
+			field.Min(Text(value))
+		case "max":
+			field.Max(Text(value))
+		case "step":
+			step, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, bad("step must be a number")
+			}
+			field.Step(step)
+		}
+	}
+	field.Type(typ)
+
+	if id == nil {
+		ref := NewEntityRef(field.name)
+		if scope != nil {
+			ref = scope.Mint(field.name)
+		}
+		id = &ref
+	}
+	field.ID(*id)
+
+	if err := field.Validate(); err != nil {
+		return nil, &TagError{Field: sf.Name, Option: tag, Reason: err.Error()}
+	}
+
+	return field, nil
+}
@@ -0,0 +1,100 @@
+package prop
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+type contactForm struct {
+	Email string `prop:"required,type=email"`
+}
+
+type signupAddress struct {
+	City string `prop:"maxlength=40"`
+}
+
+type signupForm struct {
+	contactForm
+	*signupAddress
+	Born    time.Time `prop:"type=date"`
+	Age     int       `prop:"min=18"`
+	Skipped string    `prop:"-"`
+	Plain   string
+	hidden  string
+}
+
+type cyclicForm struct {
+	*cyclicForm
+	Name string `prop:"required"`
+}
+
+type unexportedForm struct {
+	Name  string `prop:"required"`
+	token string `prop:"type=hidden"`
+}
+
+func TestSchemaOf(t *testing.T) {
+	schema, err := SchemaOf(&signupForm{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, field := range schema.Fields() {
+		names = append(names, field.Name())
+	}
+	if got, want := names, []string{"Email", "City", "Born", "Age"}; !equalStrings(got, want) {
+		t.Errorf("SchemaOf fields = %v, want %v", got, want)
+	}
+
+	if _, err := SchemaOf(cyclicForm{}, nil); err != nil {
+		t.Errorf("SchemaOf of an embedding cycle: %v", err)
+	}
+}
+
+func TestSchemaOfErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		form interface{}
+		tag  bool
+	}{
+		{name: "nil", form: nil},
+		{name: "string", form: "form"},
+		{name: "pointer to a map", form: &map[string]string{}},
+		{name: "unexported field with the tag", form: unexportedForm{}, tag: true},
+		{name: "unknown option", form: struct {
+			Name string `prop:"size=10"`
+		}{}, tag: true},
+		{name: "type the field can't hold", form: struct {
+			Agree bool `prop:"type=text"`
+		}{}, tag: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := SchemaOf(tt.form, nil)
+			if err == nil {
+				t.Fatal("SchemaOf takes the form")
+			}
+
+			var tagErr *TagError
+			if errors.As(err, &tagErr) != tt.tag {
+				t.Errorf("SchemaOf error = %v, want a *TagError %v", err, tt.tag)
+			}
+		})
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,234 @@
+package prop
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// URL is a reference to something outside, the helpers panic on javascript:, vbscript: and data: URLs
+// other than images, audio and video unless the URL is trusted, see TrustedURL.
+// A conversion such as URL(input) skips every other check, so user input should go through ParseURL or URLBuilder,
+// they only allow http, https, mailto, tel and relative URLs. HrefE, SrcE, ActionE and the other E helpers
+// return the error instead of panicking when the value comes from elsewhere
+// ex: google.com, /api/endpoint, /file.txt
+type URL string
+
+// URLError describes a URL that can't be parsed or whose scheme is not allowed
+type URLError struct {
+	URL    string
+	Reason string
+}
+
+func (e *URLError) Error() string {
+	return fmt.Sprintf("bad URL %q: %s", e.URL, e.Reason)
+}
+
+// ParseURL returns the URL when it parses and its scheme is http, https, mailto or tel, relative URLs are allowed
+func ParseURL(value string) (URL, error) {
+	return NewURLBuilder("").Resolve(value).URLE()
+}
+
+// Valid reports whether the scheme of the URL is not javascript:, vbscript: or an active data: URL
+func (u URL) Valid() bool {
+	return u.check() == nil
+}
+
+func (u URL) String() string {
+	return string(u)
+}
+
+func (u URL) check() error {
+	if _, ok := trustedURLs.Load(string(u)); ok {
+		return nil
+	}
+
+	// browsers accept what url.Parse refuses, such as /a%zz, only the schemes that run script are checked
+	switch scheme := urlScheme(string(u)); scheme {
+	case "javascript", "vbscript":
+		return &URLError{URL: string(u), Reason: "the " + scheme + ": scheme runs script, see TrustedURL"}
+	case "data":
+		if !passiveDataURL(string(u)) {
+			return &URLError{URL: string(u), Reason: "only data: URLs of images, audio and video are allowed, see TrustedURL"}
+		}
+	}
+
+	return nil
+}
+
+// passiveDataURLs are the media types of data: URLs that can't run script, SVG images can
+var passiveDataURLs = []string{"image/png", "image/gif", "image/jpeg", "image/webp", "image/avif", "image/bmp", "audio/", "video/"}
+
+func passiveDataURL(value string) bool {
+	value = strings.ToLower(urlSpaces.Replace(strings.TrimSpace(value)))
+	mediaType := strings.TrimPrefix(value[strings.IndexByte(value, ':')+1:], " ")
+
+	for _, prefix := range passiveDataURLs {
+		if strings.HasPrefix(mediaType, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// trustedConstant is unexported, so TrustedURL takes untyped constants only
+type trustedConstant string
+
+// trustedURLs are the values passed to TrustedURL, they are constants of the program so the set stays small.
+// It is global to the process and never shrinks
+var trustedURLs sync.Map
+
+// TrustedURL lets a URL written in the source code use any scheme, such as javascript:void(0).
+// It only takes untyped constants, a string from user data can't be passed to it.
+// The trust is global: once a value is trusted, the same value passes the checks of every helper in the process,
+// whether or not it went through TrustedURL there
+// ex: Href(TrustedURL("javascript:void(0)"))
+func TrustedURL(value trustedConstant) URL {
+	trustedURLs.Store(string(value), true)
+
+	return URL(value)
+}
+
+// URLBuilder resolves a reference against a base, appends escaped path segments and builds the query.
+// The scheme of the result must be allowed, http, https, mailto, tel and relative URLs are by default
+// ex: NewURLBuilder("https://example.com/api/").Path("users", name).Query("tab", "posts").URL()
+// => https://example.com/api/users/John%20Doe?tab=posts
+type URLBuilder struct {
+	base     URL
+	ref      string
+	segments []string
+	query    url.Values
+	fragment string
+	schemes  map[string]bool
+}
+
+// Resolve resolves the reference against the base, an absolute reference replaces it
+func (b *URLBuilder) Resolve(ref string) *URLBuilder {
+	b.ref = ref
+
+	return b
+}
+
+// Path appends the segments to the path, each of them is escaped, so a slash in a segment doesn't start a new one
+func (b *URLBuilder) Path(segments ...string) *URLBuilder {
+	b.segments = append(b.segments, segments...)
+
+	return b
+}
+
+// Query adds the values of the key to the query, the query of the base or the reference is kept
+func (b *URLBuilder) Query(key string, values ...string) *URLBuilder {
+	for _, value := range values {
+		b.query.Add(key, value)
+	}
+
+	return b
+}
+
+func (b *URLBuilder) Fragment(value string) *URLBuilder {
+	b.fragment = value
+
+	return b
+}
+
+// AllowURLSchemes allows the schemes on top of http, https, mailto and tel.
+// The helpers still refuse javascript:, vbscript: and active data: URLs that are not trusted
+func (b *URLBuilder) AllowURLSchemes(schemes ...string) *URLBuilder {
+	for _, scheme := range schemes {
+		b.schemes[strings.ToLower(strings.TrimSuffix(scheme, ":"))] = true
+	}
+
+	return b
+}
+
+func (b *URLBuilder) Validate() error {
+	_, err := b.URLE()
+
+	return err
+}
+
+// URL returns the URL, it panics when the base or the reference doesn't parse, a segment is . or ..
+// or the scheme is not allowed
+func (b *URLBuilder) URL() URL {
+	u, err := b.URLE()
+	if err != nil {
+		panic(err)
+	}
+
+	return u
+}
+
+// URLE is URL that returns an error instead of panicking
+func (b *URLBuilder) URLE() (URL, error) {
+	u, err := url.Parse(strings.TrimSpace(string(b.base)))
+	if err != nil {
+		return "", &URLError{URL: string(b.base), Reason: err.Error()}
+	}
+
+	if b.ref != "" {
+		ref, err := url.Parse(strings.TrimSpace(b.ref))
+		if err != nil {
+			return "", &URLError{URL: b.ref, Reason: err.Error()}
+		}
+
+		// a relative reference stays relative without a base
+		if b.base != "" {
+			ref = u.ResolveReference(ref)
+		}
+		u = ref
+	}
+
+	if len(b.segments) != 0 {
+		path := u.EscapedPath()
+		if (path != "" || u.Host != "") && !strings.HasSuffix(path, "/") {
+			path += "/"
+		}
+
+		escaped := make([]string, 0, len(b.segments))
+		for _, segment := range b.segments {
+			if segment == "." || segment == ".." {
+				return "", &URLError{URL: u.String(), Reason: "the path segment " + segment + " would move up the path"}
+			}
+
+			escaped = append(escaped, url.PathEscape(segment))
+		}
+		path += strings.Join(escaped, "/")
+
+		u.Path, _ = url.PathUnescape(path)
+		u.RawPath = path
+	}
+
+	if len(b.query) != 0 {
+		query := u.Query()
+		for key, values := range b.query {
+			query[key] = append(query[key], values...)
+		}
+		u.RawQuery = query.Encode()
+	}
+
+	if b.fragment != "" {
+		u.Fragment, u.RawFragment = b.fragment, ""
+	}
+
+	if scheme := strings.ToLower(u.Scheme); scheme != "" && !b.schemes[scheme] {
+		return "", &URLError{URL: u.String(), Reason: "the " + scheme + ": scheme is not allowed, see URLBuilder.AllowURLSchemes"}
+	}
+
+	return URL(u.String()), nil
+}
+
+// NewURLBuilder makes a builder of URLs relative to the base, the base may be empty or relative itself
+func NewURLBuilder(base URL) *URLBuilder {
+	return &URLBuilder{
+		base:    base,
+		query:   url.Values{},
+		schemes: defaultURLSchemes(),
+	}
+}
+
+// defaultURLSchemes are the schemes allowed in URLs from user data
+func defaultURLSchemes() map[string]bool {
+	return map[string]bool{"http": true, "https": true, "mailto": true, "tel": true}
+}
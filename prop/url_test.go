@@ -0,0 +1,100 @@
+package prop
+
+import "testing"
+
+func TestParseURL(t *testing.T) {
+	tests := []struct {
+		value string
+		want  URL
+		err   bool
+	}{
+		{value: "https://example.com/a?b=c", want: "https://example.com/a?b=c"},
+		{value: "/api/endpoint", want: "/api/endpoint"},
+		{value: "mailto:john@example.com", want: "mailto:john@example.com"},
+		{value: "  tel:+123  ", want: "tel:+123"},
+		{value: "javascript:alert(1)", err: true},
+		{value: "JavaScript:alert(1)", err: true},
+		{value: "data:image/png;base64,AA==", err: true},
+		{value: "ftp://example.com", err: true},
+		{value: "http://[::1", err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseURL(tt.value)
+			if (err != nil) != tt.err {
+				t.Fatalf("ParseURL error = %v, want error %v", err, tt.err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseURL = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestURLCheck(t *testing.T) {
+	tests := []struct {
+		value URL
+		err   bool
+	}{
+		{value: "google.com"},
+		// url.Parse refuses them, browsers don't
+		{value: "/a%zz"},
+		{value: "/search?q=100%"},
+		{value: "http://[::1"},
+		{value: "https://example.com/a b"},
+		{value: "%"},
+		{value: "javascript%3Aalert(1)"},
+		{value: "JAVASCRIPT:alert(%zz)", err: true},
+		{value: "data:image/png;base64,AA=="},
+		{value: "data:image/svg+xml,<svg/>", err: true},
+		{value: "data:text/html,<script></script>", err: true},
+		{value: " javascript:alert(1)", err: true},
+		{value: "java\tscript:alert(1)", err: true},
+		{value: "vbscript:msgbox", err: true},
+		{value: TrustedURL("javascript:void(0)")},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.value), func(t *testing.T) {
+			if _, err := HrefE(tt.value); (err != nil) != tt.err {
+				t.Errorf("HrefE error = %v, want error %v", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestURLBuilder(t *testing.T) {
+	tests := []struct {
+		name    string
+		builder *URLBuilder
+		want    URL
+		err     bool
+	}{
+		{
+			name:    "path and query",
+			builder: NewURLBuilder("https://example.com/api/").Path("users", "John Doe").Query("tab", "posts"),
+			want:    "https://example.com/api/users/John%20Doe?tab=posts",
+		},
+		{name: "escaped slash", builder: NewURLBuilder("/files").Path("a/b"), want: "/files/a%2Fb"},
+		{name: "dot segment", builder: NewURLBuilder("/files").Path(".."), err: true},
+		{name: "absolute reference", builder: NewURLBuilder("https://example.com/").Resolve("ftp://example.com"), err: true},
+		{
+			name:    "allowed scheme",
+			builder: NewURLBuilder("").Resolve("ftp://example.com/file").AllowURLSchemes("ftp:"),
+			want:    "ftp://example.com/file",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.builder.URLE()
+			if (err != nil) != tt.err {
+				t.Fatalf("URLE error = %v, want error %v", err, tt.err)
+			}
+			if got != tt.want {
+				t.Errorf("URLE = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,302 @@
+package prop
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"unsafe"
+
+	"github.com/hexops/vecty"
+)
+
+// vectyHTML is a copy of the unexported fields of vecty.HTML
+type vectyHTML struct {
+	tag        string
+	text       string
+	innerHTML  string
+	classes    map[string]struct{}
+	styles     map[string]string
+	dataset    map[string]string
+	properties map[string]interface{}
+	attributes map[string]interface{}
+	children   []vecty.ComponentOrHTML
+}
+
+// vectyVersion is the version of vecty whose vecty.HTML layout readVectyHTML knows, go.mod pins it
+const vectyVersion = "v0.6.0"
+
+// vectyFields are the unexported fields of vecty.HTML read by readVectyHTML and their types
+var vectyFields = map[string]reflect.Type{
+	"tag":        reflect.TypeOf(""),
+	"text":       reflect.TypeOf(""),
+	"innerHTML":  reflect.TypeOf(""),
+	"classes":    reflect.TypeOf(map[string]struct{}{}),
+	"styles":     reflect.TypeOf(map[string]string{}),
+	"dataset":    reflect.TypeOf(map[string]string{}),
+	"properties": reflect.TypeOf(map[string]interface{}{}),
+	"attributes": reflect.TypeOf(map[string]interface{}{}),
+	"children":   reflect.TypeOf([]vecty.ComponentOrHTML{}),
+}
+
+var (
+	vectyLayoutOnce sync.Once
+	vectyLayoutErr  error
+)
+
+// checkVectyLayout reports whether vecty.HTML and vecty.KeyedList still have the fields readVectyHTML reads
+func checkVectyLayout() error {
+	vectyLayoutOnce.Do(func() {
+		layout := func(t reflect.Type, name string, want reflect.Type) {
+			if f, ok := t.FieldByName(name); vectyLayoutErr == nil && (!ok || f.Type != want) {
+				vectyLayoutErr = fmt.Errorf("prop: %s has no field %s of type %s, FakeDOM conversion supports vecty %s",
+					t, name, want, vectyVersion)
+			}
+		}
+
+		html := reflect.TypeOf(vecty.HTML{})
+		for name, want := range vectyFields {
+			layout(html, name, want)
+		}
+		layout(reflect.TypeOf(vecty.KeyedList{}), "html", reflect.TypeOf(&vecty.HTML{}))
+	})
+
+	return vectyLayoutErr
+}
+
+// readField returns the value of an unexported field, checkVectyLayout checks the field first
+func readField(v reflect.Value, name string) interface{} {
+	f := v.FieldByName(name)
+
+	return reflect.NewAt(f.Type(), unsafe.Pointer(f.UnsafeAddr())).Elem().Interface()
+}
+
+func readVectyHTML(h *vecty.HTML) (vectyHTML, error) {
+	if err := checkVectyLayout(); err != nil {
+		return vectyHTML{}, err
+	}
+
+	fields := reflect.ValueOf(h).Elem()
+
+	return vectyHTML{
+		tag:        readField(fields, "tag").(string),
+		text:       readField(fields, "text").(string),
+		innerHTML:  readField(fields, "innerHTML").(string),
+		classes:    readField(fields, "classes").(map[string]struct{}),
+		styles:     readField(fields, "styles").(map[string]string),
+		dataset:    readField(fields, "dataset").(map[string]string),
+		properties: readField(fields, "properties").(map[string]interface{}),
+		attributes: readField(fields, "attributes").(map[string]interface{}),
+		children:   readField(fields, "children").([]vecty.ComponentOrHTML),
+	}, nil
+}
+
+// attrs converts everything applied to the element into content attributes sorted by name
+func (h vectyHTML) attrs() []*Attr {
+	values := map[string]*Attr{}
+
+	set := func(key string, value interface{}) {
+		switch value := value.(type) {
+		case bool:
+			if keywords := domAttrs[key].keywords; keywords != [2]string{} {
+				var keyword string
+				if value {
+					keyword = keywords[1]
+				} else {
+					keyword = keywords[0]
+				}
+
+				values[key] = &Attr{key: key, value: keyword}
+			} else if value {
+				values[key] = &Attr{key: key, boolean: true}
+			} else {
+				delete(values, key)
+			}
+		case nil:
+			delete(values, key)
+		case string:
+			values[key] = &Attr{key: key, value: value, boolean: value == "" && domAttrs[key].boolean}
+		default:
+			values[key] = &Attr{key: key, value: fmt.Sprint(value)}
+		}
+	}
+
+	for key, value := range h.properties {
+		if attr, ok := propertyAttrs[key]; ok {
+			key = attr
+		}
+		set(strings.ToLower(key), value)
+	}
+	for key, value := range h.attributes {
+		set(key, value)
+	}
+
+	if len(h.classes) != 0 {
+		classes := make([]string, 0, len(h.classes))
+		for class := range h.classes {
+			classes = append(classes, class)
+		}
+		sort.Strings(classes)
+
+		set("class", strings.Join(classes, " "))
+	}
+
+	if len(h.styles) != 0 {
+		styles := make([]string, 0, len(h.styles))
+		for key, value := range h.styles {
+			styles = append(styles, key+": "+value)
+		}
+		sort.Strings(styles)
+
+		set("style", strings.Join(styles, "; "))
+	}
+
+	for key, value := range h.dataset {
+		set("data-"+datasetAttr(key), value)
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	attrs := make([]*Attr, 0, len(keys))
+	for _, key := range keys {
+		attrs = append(attrs, values[key])
+	}
+
+	return attrs
+}
+
+// datasetAttr converts a dataset key to the attribute name without the data- prefix
+// ex: fooBar => foo-bar
+func datasetAttr(key string) string {
+	var name strings.Builder
+	for _, r := range key {
+		if 'A' <= r && r <= 'Z' {
+			name.WriteByte('-')
+			r += 'a' - 'A'
+		}
+		name.WriteRune(r)
+	}
+
+	return name.String()
+}
+
+// applyAttrs converts the applyers into content attributes of the element keeping their order
+func applyAttrs(name string, applyers []vecty.Applyer) ([]*Attr, error) {
+	attrs := make([]*Attr, 0, len(applyers))
+
+	for _, applyer := range applyers {
+		if attr, ok := applyer.(*Attr); ok {
+			attrs = append(attrs, attr)
+			continue
+		}
+
+		h, err := readVectyHTML(vecty.Tag(name, vecty.Markup(applyer)))
+		if err != nil {
+			return nil, err
+		}
+		attrs = append(attrs, h.attrs()...)
+	}
+
+	return attrs, nil
+}
+
+// FromVecty renders the vecty tree into a FakeDOM tree, the result is a *Document without a doctype.
+// Components are rendered, event listeners are dropped. Outside of a browser the program has to import prop/native
+func FromVecty(c vecty.ComponentOrHTML) (FakeDOM, error) {
+	nodes, err := fromVecty(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Document{
+		nodes: nodes,
+	}, nil
+}
+
+func fromVecty(c vecty.ComponentOrHTML) ([]FakeDOM, error) {
+	switch c := c.(type) {
+	case nil:
+		return nil, nil
+	case *vecty.HTML:
+		if c == nil {
+			return nil, nil
+		}
+
+		h, err := readVectyHTML(c)
+		if err != nil {
+			return nil, err
+		}
+		if h.tag == "" {
+			return []FakeDOM{NewTextNode(h.text)}, nil
+		}
+
+		if !tagNamePattern.MatchString(h.tag) {
+			return nil, &TagNameError{Name: h.tag}
+		}
+
+		node := &Node{
+			name:  h.tag,
+			attrs: h.attrs(),
+		}
+
+		// the value of a <textarea> is its content
+		if value, ok := node.Attr("value"); ok && strings.EqualFold(h.tag, "textarea") {
+			node.RemoveAttr("value")
+			node.nodes = append(node.nodes, NewTextNode(value))
+		}
+
+		if h.innerHTML != "" {
+			node.nodes = append(node.nodes, NewRawNode(h.innerHTML))
+		}
+
+		for _, child := range h.children {
+			children, err := fromVecty(child)
+			if err != nil {
+				return nil, err
+			}
+
+			if _, err := node.IncludeE(children...); err != nil {
+				return nil, err
+			}
+		}
+
+		return []FakeDOM{node}, nil
+	case vecty.Component:
+		return fromVecty(c.Render())
+	case vecty.List:
+		var nodes []FakeDOM
+		for _, child := range c {
+			children, err := fromVecty(child)
+			if err != nil {
+				return nil, err
+			}
+
+			nodes = append(nodes, children...)
+		}
+
+		return nodes, nil
+	case vecty.KeyedList:
+		if err := checkVectyLayout(); err != nil {
+			return nil, err
+		}
+
+		h := readField(reflect.ValueOf(&c).Elem(), "html").(*vecty.HTML)
+		if h == nil {
+			return nil, nil
+		}
+
+		html, err := readVectyHTML(h)
+		if err != nil {
+			return nil, err
+		}
+
+		return fromVecty(vecty.List(html.children))
+	default:
+		return nil, fmt.Errorf("prop: can't render %T into FakeDOM", c)
+	}
+}
@@ -0,0 +1,53 @@
+package prop
+
+import (
+	"runtime/debug"
+	"strings"
+	"testing"
+
+	"github.com/hexops/vecty"
+)
+
+func TestVectyLayout(t *testing.T) {
+	if err := checkVectyLayout(); err != nil {
+		t.Fatal(err)
+	}
+
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		t.Skip("no build info")
+	}
+	for _, dep := range info.Deps {
+		if dep.Path == "github.com/hexops/vecty" && dep.Version != vectyVersion {
+			t.Errorf("vecty %s is used, readVectyHTML knows the fields of %s", dep.Version, vectyVersion)
+		}
+	}
+}
+
+func TestFromVecty(t *testing.T) {
+	tests := []struct {
+		name string
+		tree vecty.ComponentOrHTML
+		want string
+	}{
+		{name: "element", tree: vecty.Tag("a", vecty.Markup(Href("/"), vecty.Class("b", "a")), vecty.Text("Home")),
+			want: `<a class="a b" href="/">Home</a>`},
+		{name: "list", tree: vecty.List{vecty.Tag("br"), vecty.Text("x")}, want: `<br>x`},
+		{name: "keyed list", tree: vecty.Tag("ul", vecty.List{vecty.Tag("li", vecty.Text("1"))}.WithKey("k")),
+			want: `<ul><li>1</li></ul>`},
+		{name: "textarea", tree: vecty.Tag("textarea", vecty.Markup(vecty.Property("value", "<x>"))),
+			want: `<textarea>&lt;x&gt;</textarea>`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var html strings.Builder
+			if err := RenderHTML(&html, tt.tree); err != nil {
+				t.Fatal(err)
+			}
+			if html.String() != tt.want {
+				t.Errorf("RenderHTML = %s, want %s", html.String(), tt.want)
+			}
+		})
+	}
+}
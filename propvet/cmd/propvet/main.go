@@ -0,0 +1,16 @@
+// Command propvet reports prop helpers set on elements they don't apply to.
+// It is built from the propvet module and runs on its own or as a vet tool:
+//
+//	cd propvet && go build -o /usr/local/bin/propvet ./cmd/propvet
+//	propvet ./...
+//	go vet -vettool=$(which propvet) ./...
+package main
+
+import (
+	"github.com/Hand-of-Doom/Vecty-Props/propvet"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(propvet.Analyzer)
+}
@@ -0,0 +1,15 @@
+module github.com/Hand-of-Doom/Vecty-Props/propvet
+
+go 1.24.0
+
+require (
+	github.com/Hand-of-Doom/Vecty-Props v0.0.0-00010101000000-000000000000
+	golang.org/x/tools v0.38.0
+)
+
+require (
+	golang.org/x/mod v0.29.0 // indirect
+	golang.org/x/sync v0.17.0 // indirect
+)
+
+replace github.com/Hand-of-Doom/Vecty-Props => ../
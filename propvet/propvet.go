@@ -0,0 +1,244 @@
+// Package propvet defines an Analyzer that reports prop helpers passed to vecty.Markup of an element
+// they don't apply to, such as elem.Div(vecty.Markup(prop.Href("/x"))).
+// The elements of each helper come from the attribute dataset, the same one the tests of the prop package check the helpers against.
+// It is a module of its own, so the prop package keeps its Go version and doesn't depend on golang.org/x/tools.
+// Until the main module has a tagged release, go.mod replaces it with the parent directory,
+// so the analyzer reads the dataset of the same tree as the prop package
+package propvet
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"strings"
+
+	"github.com/Hand-of-Doom/Vecty-Props/spec"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const (
+	vectyPath = "github.com/hexops/vecty"
+	propPath  = "github.com/Hand-of-Doom/Vecty-Props/prop"
+)
+
+const doc = `report prop helpers set on elements they don't apply to
+
+The analyzer finds the vecty.Markup calls among the arguments of an element constructor,
+a function such as elem.Div that returns vecty.Tag with a constant tag name, or of vecty.Tag itself.
+Every prop helper in the markup is looked up in the attribute dataset and reported
+unless its attribute is global or applies to the element.
+Helpers generated for some of the elements of their attribute, such as prop.InputType, are checked against those.
+Constant arguments are checked against the values the attribute allows on the element,
+such as the input types of prop.InputType or the link types of prop.Rel on <form>.`
+
+// Analyzer reports prop helpers set on elements they don't apply to, it exports the element constructors as facts
+var Analyzer = &analysis.Analyzer{
+	Name:      "propvet",
+	Doc:       doc,
+	Requires:  []*analysis.Analyzer{inspect.Analyzer},
+	FactTypes: []analysis.Fact{new(tagFact)},
+	Run:       run,
+}
+
+// tagFact marks an element constructor, the function returns vecty.Tag of the tag with its arguments
+type tagFact struct {
+	Tag string
+}
+
+func (*tagFact) AFact() {}
+
+func (f *tagFact) String() string {
+	return "tag <" + f.Tag + ">"
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok {
+				exportTag(pass, fn)
+			}
+		}
+	}
+
+	ds := spec.Load()
+	only := map[string][]string{}
+	for _, fn := range spec.LoadGenerated().Funcs {
+		if len(fn.Elements) != 0 {
+			only[fn.Name] = fn.Elements
+		}
+	}
+
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	inspect.Preorder([]ast.Node{(*ast.CallExpr)(nil)}, func(n ast.Node) {
+		call := n.(*ast.CallExpr)
+
+		tag, args, ok := element(pass, call)
+		if !ok {
+			return
+		}
+
+		for _, arg := range args {
+			for _, markup := range markupOf(pass, arg) {
+				check(pass, ds, only, tag, markup)
+			}
+		}
+	})
+
+	return nil, nil
+}
+
+// exportTag exports a tagFact for the function when its body is return vecty.Tag("tag", markup...)
+func exportTag(pass *analysis.Pass, fn *ast.FuncDecl) {
+	if fn.Recv != nil || fn.Body == nil || len(fn.Body.List) != 1 {
+		return
+	}
+
+	ret, ok := fn.Body.List[0].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return
+	}
+
+	call, ok := ret.Results[0].(*ast.CallExpr)
+	if !ok || !isFunc(pass, call, vectyPath, "Tag") || len(call.Args) != 2 || !call.Ellipsis.IsValid() {
+		return
+	}
+
+	tag, ok := stringConst(pass, call.Args[0])
+	if !ok {
+		return
+	}
+
+	// the markup must be passed through as it is
+	params := fn.Type.Params.List
+	if len(params) != 1 || len(params[0].Names) != 1 {
+		return
+	}
+	if ident, ok := call.Args[1].(*ast.Ident); !ok || pass.TypesInfo.Uses[ident] != pass.TypesInfo.Defs[params[0].Names[0]] {
+		return
+	}
+
+	if obj, ok := pass.TypesInfo.Defs[fn.Name].(*types.Func); ok {
+		pass.ExportObjectFact(obj, &tagFact{Tag: tag})
+	}
+}
+
+// element returns the tag and the markup arguments of a call of vecty.Tag or of an element constructor
+func element(pass *analysis.Pass, call *ast.CallExpr) (string, []ast.Expr, bool) {
+	if isFunc(pass, call, vectyPath, "Tag") && len(call.Args) != 0 {
+		tag, ok := stringConst(pass, call.Args[0])
+		return tag, call.Args[1:], ok
+	}
+
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok {
+		return "", nil, false
+	}
+
+	var fact tagFact
+	if !pass.ImportObjectFact(fn, &fact) {
+		return "", nil, false
+	}
+
+	return fact.Tag, call.Args, true
+}
+
+// markupOf returns the arguments of vecty.Markup, the ones of vecty.MarkupIf are included
+func markupOf(pass *analysis.Pass, arg ast.Expr) []ast.Expr {
+	call, ok := ast.Unparen(arg).(*ast.CallExpr)
+	if !ok {
+		return nil
+	}
+
+	var args []ast.Expr
+	switch {
+	case isFunc(pass, call, vectyPath, "Markup"):
+		args = call.Args
+	case isFunc(pass, call, vectyPath, "MarkupIf") && len(call.Args) != 0:
+		args = call.Args[1:]
+	default:
+		return nil
+	}
+
+	var markup []ast.Expr
+	for _, arg := range args {
+		if nested := markupOf(pass, arg); nested != nil {
+			markup = append(markup, nested...)
+			continue
+		}
+
+		markup = append(markup, arg)
+	}
+
+	return markup
+}
+
+// check reports the helper in the markup unless it applies to the tag,
+// only holds the helpers that set an attribute on some of its elements, such as InputType on <input>
+func check(pass *analysis.Pass, ds *spec.Dataset, only map[string][]string, tag string, markup ast.Expr) {
+	call, ok := ast.Unparen(markup).(*ast.CallExpr)
+	if !ok {
+		return
+	}
+
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != propPath || fn.Type().(*types.Signature).Recv() != nil {
+		return
+	}
+
+	attr, ok := ds.Helper(fn.Name())
+	if !ok {
+		return
+	}
+
+	names, applies := attr.Elements, attr.AppliesTo(tag)
+	if subset, ok := only[fn.Name()]; ok {
+		names, applies = subset, false
+		for _, e := range subset {
+			applies = applies || e == tag
+		}
+	}
+	if applies {
+		checkValues(pass, ds, fn, attr, tag, call)
+		return
+	}
+
+	elements := make([]string, 0, len(names))
+	for _, e := range names {
+		elements = append(elements, "<"+e+">")
+	}
+
+	pass.Reportf(call.Pos(), "prop.%s sets %s, which doesn't apply to <%s>, only to %s",
+		fn.Name(), attr.Name, tag, strings.Join(elements, ", "))
+}
+
+// checkValues reports the constant arguments the attribute doesn't allow on the tag,
+// such as prop.InputType("colour") or prop.RelTokenStylesheet on <a>
+func checkValues(pass *analysis.Pass, ds *spec.Dataset, fn *types.Func, attr spec.Attribute, tag string, call *ast.CallExpr) {
+	for _, arg := range call.Args {
+		value, ok := stringConst(pass, arg)
+		if !ok {
+			continue
+		}
+
+		if err := ds.CheckValueOn(attr, tag, value); err != nil {
+			pass.Reportf(arg.Pos(), "prop.%s: %s", fn.Name(), err)
+		}
+	}
+}
+
+func isFunc(pass *analysis.Pass, call *ast.CallExpr, path, name string) bool {
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	return ok && fn.Pkg() != nil && fn.Pkg().Path() == path && fn.Name() == name
+}
+
+func stringConst(pass *analysis.Pass, expr ast.Expr) (string, bool) {
+	tv, ok := pass.TypesInfo.Types[expr]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", false
+	}
+
+	return strings.ToLower(constant.StringVal(tv.Value)), true
+}
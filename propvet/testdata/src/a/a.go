@@ -0,0 +1,44 @@
+package a
+
+import (
+	"elem"
+
+	"github.com/Hand-of-Doom/Vecty-Props/prop"
+	"github.com/hexops/vecty"
+)
+
+func applicability() {
+	vecty.Tag("a", vecty.Markup(prop.Href("/a")))
+	vecty.Tag("div", vecty.Markup(prop.Href("/a"))) // want `prop.Href sets href, which doesn't apply to <div>, only to <a>, <area>, <base>, <link>`
+	elem.Div(vecty.Markup(prop.Href("/a")))         // want `prop.Href sets href, which doesn't apply to <div>`
+	elem.Div(vecty.Markup(prop.ID("a"), prop.Lang("en")))
+	elem.Section(vecty.Markup(prop.Href("/a")))
+
+	// InputType is generated for <input> only, although type applies to <button> too
+	elem.Input(vecty.Markup(prop.InputType(prop.InputTypeCaseText)))
+	vecty.Tag("button", vecty.Markup(prop.InputType(prop.InputTypeCaseText))) // want `prop.InputType sets type, which doesn't apply to <button>, only to <input>`
+
+	elem.Div(vecty.MarkupIf(true, prop.Checked(true)))                      // want `prop.Checked sets checked, which doesn't apply to <div>`
+	elem.Div(vecty.Markup(vecty.Markup(prop.Target(prop.TargetCaseBlank)))) // want `prop.Target sets target`
+}
+
+func enumConstants(typ prop.InputTypeCase) {
+	elem.Input(vecty.Markup(prop.InputType("colour")))                    // want `prop.InputType: "colour" is not a valid value of type`
+	elem.Input(vecty.Markup(prop.InputType(prop.InputTypeCase("range")))) // valid although it has no constant in the fixture
+	elem.Input(vecty.Markup(prop.InputType(typ)))
+	vecty.Tag("a", vecty.Markup(prop.Target("_parent2"))) // want `prop.Target: "_parent2" is not a valid value of target`
+	vecty.Tag("a", vecty.Markup(prop.Target("frame")))
+}
+
+func literalValues(lang string) {
+	elem.Div(vecty.Markup(prop.Lang("en_US"))) // want `prop.Lang: "en_us" is not a valid value of lang`
+	elem.Div(vecty.Markup(prop.Lang("en-US"), prop.Lang(lang)))
+	vecty.Tag("link", vecty.Markup(prop.Media("screen and (min-width: 600px)")))
+	vecty.Tag("link", vecty.Markup(prop.Media("(min-colour: 8)"))) // want `prop.Media: .* unknown media feature min-colour`
+}
+
+func elementValues() {
+	elem.Form(vecty.Markup(prop.Rel(prop.RelTokenNofollow)))
+	elem.Form(vecty.Markup(prop.Rel(prop.RelTokenNofollow, prop.RelTokenStylesheet))) // want `prop.Rel: "stylesheet" is not a valid value of rel: stylesheet doesn't apply to <form>`
+	vecty.Tag("link", vecty.Markup(prop.Rel(prop.RelTokenStylesheet)))
+}
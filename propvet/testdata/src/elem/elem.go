@@ -0,0 +1,21 @@
+// Package elem has element constructors like the ones of github.com/hexops/vecty/elem
+package elem
+
+import "github.com/hexops/vecty"
+
+func Div(markup ...vecty.MarkupOrChild) *vecty.HTML { // want Div:"tag <div>"
+	return vecty.Tag("div", markup...)
+}
+
+func Form(markup ...vecty.MarkupOrChild) *vecty.HTML { // want Form:"tag <form>"
+	return vecty.Tag("form", markup...)
+}
+
+func Input(markup ...vecty.MarkupOrChild) *vecty.HTML { // want Input:"tag <input>"
+	return vecty.Tag("input", markup...)
+}
+
+// Section is not a constructor, it doesn't pass the markup through
+func Section(markup ...vecty.MarkupOrChild) *vecty.HTML {
+	return vecty.Tag("section", vecty.Markup())
+}
@@ -0,0 +1,40 @@
+// Package prop has the helpers of github.com/Hand-of-Doom/Vecty-Props/prop used by the tests, the analyzer only looks at their names
+package prop
+
+import "github.com/hexops/vecty"
+
+type URL string
+
+type InputTypeCase string
+
+const (
+	InputTypeCaseText     InputTypeCase = "text"
+	InputTypeCaseCheckbox InputTypeCase = "checkbox"
+)
+
+type RelToken string
+
+const (
+	RelTokenNofollow   RelToken = "nofollow"
+	RelTokenStylesheet RelToken = "stylesheet"
+)
+
+type TargetCase string
+
+const TargetCaseBlank TargetCase = "_blank"
+
+func Href(value URL) vecty.Applyer { return nil }
+
+func InputType(c InputTypeCase) vecty.Applyer { return nil }
+
+func Rel(tokens ...RelToken) vecty.Applyer { return nil }
+
+func Target(c TargetCase) vecty.Applyer { return nil }
+
+func Lang(value string) vecty.Applyer { return nil }
+
+func Media(value string) vecty.Applyer { return nil }
+
+func ID(value string) vecty.Applyer { return nil }
+
+func Checked(flag bool) vecty.Applyer { return nil }
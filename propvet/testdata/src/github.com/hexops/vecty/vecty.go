@@ -0,0 +1,16 @@
+// Package vecty is the part of github.com/hexops/vecty the analyzer looks at
+package vecty
+
+type Applyer interface{}
+
+type MarkupOrChild interface{}
+
+type MarkupList struct{}
+
+type HTML struct{}
+
+func Tag(tag string, m ...MarkupOrChild) *HTML { return &HTML{} }
+
+func Markup(m ...Applyer) MarkupList { return MarkupList{} }
+
+func MarkupIf(condition bool, markup ...Applyer) MarkupList { return MarkupList{} }
@@ -0,0 +1,365 @@
+// Package spec is the checked-in dataset of the HTML attributes covered by the prop package:
+// their names, value grammars and the elements each applies to, and the helpers cmd/propgen generates for them.
+// It is public so that tools built outside of this module, such as propvet, can read it
+package spec
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+//go:embed attributes.json
+var data []byte
+
+type Attribute struct {
+	// Name is the content attribute
+	Name string `json:"name"`
+	// Property is the DOM property, it is empty when the content attribute is set instead
+	Property string `json:"property"`
+	// Helpers are the functions of the prop package that set the attribute
+	Helpers []string `json:"helpers"`
+	// Type is the Go type of the constants accepted by the helpers
+	Type     string   `json:"type,omitempty"`
+	Elements []string `json:"elements,omitempty"`
+	Global   bool     `json:"global,omitempty"`
+	Boolean  bool     `json:"boolean,omitempty"`
+	// Value is the name of the value grammar
+	Value  string   `json:"value"`
+	Values []string `json:"values,omitempty"`
+	// ElementValues narrow down the values for some of the elements, such as the link types of <form>
+	ElementValues map[string][]string `json:"elementValues,omitempty"`
+}
+
+// AppliesTo reports whether the attribute can be set on the element
+func (a Attribute) AppliesTo(element string) bool {
+	if a.Global {
+		return true
+	}
+
+	for _, e := range a.Elements {
+		if strings.EqualFold(e, element) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValuesOf returns the values the attribute allows on the element
+func (a Attribute) ValuesOf(element string) []string {
+	if values, ok := a.ElementValues[strings.ToLower(element)]; ok {
+		return values
+	}
+
+	return a.Values
+}
+
+type Dataset struct {
+	Attributes    []Attribute `json:"attributes"`
+	MediaTypes    []string    `json:"mediaTypes"`
+	MediaFeatures []string    `json:"mediaFeatures"`
+}
+
+var (
+	loadOnce sync.Once
+	dataset  *Dataset
+)
+
+// Load returns the dataset, it is parsed the first time it is needed rather than when the package is loaded
+func Load() *Dataset {
+	loadOnce.Do(func() {
+		dataset = &Dataset{}
+		if err := json.Unmarshal(data, dataset); err != nil {
+			panic("spec: broken attributes.json: " + err.Error())
+		}
+	})
+
+	return dataset
+}
+
+//go:embed helpers.json
+var generatedData []byte
+
+// Func is a helper generated by cmd/propgen, it passes its argument to the attribute as it is.
+// Arguments of an enum type, of MIME or of URL are checked first
+type Func struct {
+	Name      string `json:"name"`
+	Attribute string `json:"attribute"`
+	// Param is the name of the argument, Type is its Go type
+	Param string `json:"param"`
+	Type  string `json:"type"`
+	// Doc is the doc comment without the name of the helper and the elements
+	Doc string `json:"doc"`
+	// Elements narrow down the elements of the attribute, such as <input> for InputType
+	Elements   []string `json:"elements,omitempty"`
+	Deprecated string   `json:"deprecated,omitempty"`
+}
+
+// Enum is a type of constants generated by cmd/propgen
+type Enum struct {
+	Type       string `json:"type"`
+	Doc        string `json:"doc,omitempty"`
+	Deprecated string `json:"deprecated,omitempty"`
+	// Attribute is the attribute the values are checked for
+	Attribute string `json:"attribute"`
+	// Grammar is how the values are checked: only the constants are valid when it is empty,
+	// attribute allows everything the grammar of the attribute does, mime also allows any MIME type
+	Grammar   string  `json:"grammar,omitempty"`
+	Constants []Const `json:"constants"`
+}
+
+type Const struct {
+	Name  string `json:"name"`
+	Value string `json:"value,omitempty"`
+	// Alias is the constant this one is another name of, it is set instead of the value
+	Alias      string `json:"alias,omitempty"`
+	Deprecated string `json:"deprecated,omitempty"`
+}
+
+// Generated is the part of the prop package generated by cmd/propgen
+type Generated struct {
+	Enums []Enum `json:"enums"`
+	Funcs []Func `json:"helpers"`
+}
+
+var (
+	generatedOnce sync.Once
+	generated     *Generated
+)
+
+// LoadGenerated returns the helpers and the enums to generate, they are parsed once
+func LoadGenerated() *Generated {
+	generatedOnce.Do(func() {
+		generated = &Generated{}
+		if err := json.Unmarshal(generatedData, generated); err != nil {
+			panic("spec: broken helpers.json: " + err.Error())
+		}
+	})
+
+	return generated
+}
+
+func (d *Dataset) Attribute(name string) (Attribute, bool) {
+	for _, a := range d.Attributes {
+		if a.Name == name {
+			return a, true
+		}
+	}
+
+	return Attribute{}, false
+}
+
+// Helper returns the attribute set by the helper of the prop package
+func (d *Dataset) Helper(name string) (Attribute, bool) {
+	for _, a := range d.Attributes {
+		for _, helper := range a.Helpers {
+			if helper == name {
+				return a, true
+			}
+		}
+	}
+
+	return Attribute{}, false
+}
+
+const (
+	floatPattern    = `-?(?:[0-9]+(?:\.[0-9]+)?|\.[0-9]+)(?:[eE][+-]?[0-9]+)?`
+	datePattern     = `[0-9]{4,}-[0-9]{2}-[0-9]{2}`
+	timePattern     = `[0-9]{2}:[0-9]{2}(?::[0-9]{2}(?:\.[0-9]{1,3})?)?`
+	zonePattern     = `(?:Z|[+-][0-9]{2}:?[0-9]{2})`
+	durationPattern = `P(?:[0-9]+D)?(?:T(?:[0-9]+H)?(?:[0-9]+M)?(?:[0-9]+(?:\.[0-9]{1,3})?S)?)?` +
+		`|(?:\s*[0-9]+(?:\.[0-9]{1,3})?\s*[WwDdHhMmSs])+\s*`
+	mimePattern = `[A-Za-z0-9!#$&^_.+-]+/[A-Za-z0-9!#$&^_.+-]+`
+
+	datesPattern = `[0-9]{4,}-[0-9]{2}|` + datePattern + `|[0-9]{2}-[0-9]{2}|` + timePattern + `|` +
+		datePattern + `[T ]` + timePattern + zonePattern + `?|` + zonePattern + `|[0-9]{4,}-W[0-9]{2}|[0-9]{4,}`
+)
+
+var (
+	grammarsOnce sync.Once
+	grammars     map[string]*regexp.Regexp
+
+	autofillGrammar     *regexp.Regexp
+	mediaFeaturePattern *regexp.Regexp
+	mediaParens         *regexp.Regexp
+)
+
+// compileGrammars compiles the grammars the first time a value is checked,
+// a program that never checks one, such as a WASM client, doesn't pay for them at startup
+func compileGrammars() {
+	grammarsOnce.Do(func() {
+		grammars = map[string]*regexp.Regexp{
+			"positive-integer":     regexp.MustCompile(`^0*[1-9][0-9]*$`),
+			"non-negative-integer": regexp.MustCompile(`^[0-9]+$`),
+			"integer":              regexp.MustCompile(`^-?[0-9]+$`),
+			"float":                regexp.MustCompile(`^` + floatPattern + `$`),
+			"id":                   regexp.MustCompile(`^[^\t\n\f\r ]+$`),
+			"id-list":              regexp.MustCompile(`^[^\t\n\f\r ]+(?:[\t\n\f\r ]+[^\t\n\f\r ]+)*$`),
+			"hash-name":            regexp.MustCompile(`^#.+$`),
+			"charset":              regexp.MustCompile(`^[A-Za-z0-9._:-]+$`),
+			"charset-list":         regexp.MustCompile(`^[A-Za-z0-9._:-]+(?: +[A-Za-z0-9._:-]+)*$`),
+			"lang":                 regexp.MustCompile(`^(?:[A-Za-z]{1,8}(?:-[A-Za-z0-9]{1,8})*)?$`),
+			"coords":               regexp.MustCompile(`^-?[0-9]+%?(?:,-?[0-9]+%?)*$`),
+			"datetime":             regexp.MustCompile(`^(?:` + datesPattern + `|` + durationPattern + `)$`),
+			"input-value":          regexp.MustCompile(`^(?:` + floatPattern + `|` + datesPattern + `)$`),
+			"step":                 regexp.MustCompile(`^(?:any|` + floatPattern + `)$`),
+			"navigable":            regexp.MustCompile(`^(?:_blank|_self|_parent|_top|[^_].*)$`),
+			"srcset": regexp.MustCompile(`^[^\s,]\S*(?:\s+(?:[0-9]+w|[0-9.]+x))?` +
+				`(?:\s*,\s*[^\s,]\S*(?:\s+(?:[0-9]+w|[0-9.]+x))?)*$`),
+			"mime-list": regexp.MustCompile(`^(?:\.[^\s,]+|[A-Za-z0-9!#$&^_.+-]+/(?:\*|[A-Za-z0-9!#$&^_.+-]+))` +
+				`(?:\s*,\s*(?:\.[^\s,]+|[A-Za-z0-9!#$&^_.+-]+/(?:\*|[A-Za-z0-9!#$&^_.+-]+)))*$`),
+			"type":  regexp.MustCompile(`^(?:` + mimePattern + `(?:;.*)?|1|a|A|i|I)$`),
+			"sizes": regexp.MustCompile(`^\S.*$`),
+		}
+
+		autofillGrammar = regexp.MustCompile(`^(?:on|off|(?:section-\S+ )?(?:(?:shipping|billing) )?` +
+			`(?:(?:home|work|mobile|fax|pager) )?[a-z0-9-]+(?: webauthn)?)$`)
+
+		mediaFeaturePattern = regexp.MustCompile(`\(\s*([A-Za-z-]+)\s*[:)]`)
+		mediaParens = regexp.MustCompile(`\([^()]*\)`)
+	})
+}
+
+// GrammarError is a value that doesn't match the grammar of the attribute
+type GrammarError struct {
+	Attr   string
+	Value  string
+	Reason string
+}
+
+func (e *GrammarError) Error() string {
+	return fmt.Sprintf("%q is not a valid value of %s: %s", e.Value, e.Attr, e.Reason)
+}
+
+// CheckValue reports whether the value matches the grammar of the attribute, the error is a *GrammarError
+func (d *Dataset) CheckValue(a Attribute, value string) error {
+	compileGrammars()
+
+	bad := func(reason string) error {
+		return &GrammarError{Attr: a.Name, Value: value, Reason: reason}
+	}
+
+	switch a.Value {
+	case "text", "url", "html", "regexp":
+		return nil
+	case "boolean":
+		if value != "" {
+			return bad("boolean attributes have no value")
+		}
+
+		return nil
+	case "enum", "type":
+		for _, v := range a.Values {
+			if strings.EqualFold(v, value) {
+				return nil
+			}
+		}
+		if a.Value == "type" && grammars["type"].MatchString(value) {
+			return nil
+		}
+
+		return bad("expected one of " + strings.Join(a.Values, ", "))
+	case "token-list":
+		for _, token := range strings.Fields(value) {
+			if !contains(a.Values, strings.ToLower(token)) {
+				return bad("unknown token " + token)
+			}
+		}
+
+		return nil
+	case "key-list":
+		for _, key := range strings.Fields(value) {
+			if len([]rune(key)) != 1 {
+				return bad("every key must be a single character")
+			}
+		}
+
+		return nil
+	case "media-query":
+		return d.checkMediaQuery(a, value)
+	case "autocomplete":
+		if !autofillGrammar.MatchString(strings.ToLower(value)) {
+			return bad("expected on, off or [section-*] [shipping|billing] [home|work|mobile|fax|pager] field [webauthn]")
+		}
+
+		tokens := strings.Fields(strings.ToLower(value))
+		if len(tokens) != 1 || (tokens[0] != "on" && tokens[0] != "off") {
+			// the field name is the last token before webauthn
+			field := tokens[len(tokens)-1]
+			if field == "webauthn" {
+				field = tokens[len(tokens)-2]
+			}
+			if !contains(a.Values, field) || field == "on" || field == "off" {
+				return bad("unknown autofill field name " + field)
+			}
+		}
+
+		return nil
+	}
+
+	grammar, ok := grammars[a.Value]
+	if !ok {
+		return bad("unknown grammar " + a.Value)
+	}
+	if !grammar.MatchString(value) {
+		return bad("expected " + a.Value)
+	}
+
+	return nil
+}
+
+// CheckValueOn is CheckValue that also reports the keywords the attribute doesn't allow on the element
+func (d *Dataset) CheckValueOn(a Attribute, element, value string) error {
+	if err := d.CheckValue(a, value); err != nil {
+		return err
+	}
+	if _, ok := a.ElementValues[strings.ToLower(element)]; !ok {
+		return nil
+	}
+
+	allowed := a.ValuesOf(element)
+	for _, token := range strings.Fields(value) {
+		if !contains(allowed, strings.ToLower(token)) {
+			return &GrammarError{Attr: a.Name, Value: value, Reason: token + " doesn't apply to <" + element + ">"}
+		}
+	}
+
+	return nil
+}
+
+func (d *Dataset) checkMediaQuery(a Attribute, value string) error {
+	for _, match := range mediaFeaturePattern.FindAllStringSubmatch(value, -1) {
+		feature := strings.TrimPrefix(strings.TrimPrefix(strings.ToLower(match[1]), "min-"), "max-")
+		if !contains(d.MediaFeatures, feature) {
+			return &GrammarError{Attr: a.Name, Value: value, Reason: "unknown media feature " + match[1]}
+		}
+	}
+
+	for _, query := range strings.Split(mediaParens.ReplaceAllString(value, ""), ",") {
+		for _, word := range strings.Fields(query) {
+			word = strings.ToLower(word)
+			if word == "and" || word == "not" || word == "only" || word == "or" {
+				continue
+			}
+			if !contains(d.MediaTypes, word) {
+				return &GrammarError{Attr: a.Name, Value: value, Reason: "unknown media type " + word}
+			}
+		}
+	}
+
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
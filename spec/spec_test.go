@@ -0,0 +1,48 @@
+package spec
+
+import "testing"
+
+func TestCheckValueOn(t *testing.T) {
+	tests := []struct {
+		attr    string
+		element string
+		value   string
+		err     bool
+	}{
+		{attr: "lang", value: "en-US"},
+		{attr: "lang", value: "en_US", err: true},
+		{attr: "media", value: "screen and (min-width: 600px)"},
+		{attr: "media", value: "(min-colour: 8)", err: true},
+		{attr: "media", value: "paper", err: true},
+		{attr: "autocomplete", value: "section-a shipping street-address"},
+		{attr: "autocomplete", value: "shipping billing name", err: true},
+		{attr: "datetime", value: "2024-01-02T15:04Z"},
+		{attr: "datetime", value: "PT2H30M"},
+		{attr: "datetime", value: "yesterday", err: true},
+		{attr: "rel", element: "a", value: "noopener noreferrer"},
+		{attr: "rel", element: "form", value: "stylesheet", err: true},
+		{attr: "tabindex", value: "-1"},
+		{attr: "tabindex", value: "1.5", err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.attr+" "+tt.value, func(t *testing.T) {
+			a, ok := Load().Attribute(tt.attr)
+			if !ok {
+				t.Fatalf("%s is missing from the dataset", tt.attr)
+			}
+
+			if err := Load().CheckValueOn(a, tt.element, tt.value); (err != nil) != tt.err {
+				t.Errorf("CheckValueOn error = %v, want error %v", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestHelpers(t *testing.T) {
+	for _, f := range LoadGenerated().Funcs {
+		if _, ok := Load().Helper(f.Name); !ok {
+			t.Errorf("the generated helper %s is not in attributes.json", f.Name)
+		}
+	}
+}